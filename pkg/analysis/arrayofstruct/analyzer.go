@@ -21,16 +21,21 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
 const name = "arrayofstruct"
 
+//nolint:gochecknoglobals
+var requiredFieldCheck = checks.New(name, "required-field", config.SeverityWarning)
+
 // Analyzer is the analyzer for the arrayofstruct package.
 // It checks that arrays containing structs have at least one required field.
 var Analyzer = &analysis.Analyzer{
@@ -41,6 +46,8 @@ var Analyzer = &analysis.Analyzer{
 }
 
 func init() {
+	checks.DefaultRegistry().Register(requiredFieldCheck)
+
 	markershelper.DefaultRegistry().Register(markers.KubebuilderExactlyOneOf)
 }
 
@@ -122,7 +129,7 @@ func getArrayElementType(pass *analysis.Pass, field *ast.Field) ast.Expr {
 // reportArrayOfStructIssue reports a diagnostic for an array of structs without required fields.
 func reportArrayOfStructIssue(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string) {
 	message := fmt.Sprintf("%s is an array of structs, but the struct has no required fields. At least one field should be marked as required to prevent ambiguous YAML configurations", qualifiedFieldName)
-	pass.Report(analysis.Diagnostic{
+	requiredFieldCheck.Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		Message: message,
 	})
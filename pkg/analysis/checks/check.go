@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package checks
+
+import (
+	"fmt"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+// docsURL is the base URL for the linter documentation.
+// Each linter has a section within the document, anchored by the linter name.
+const docsURL = "https://github.com/kubernetes-sigs/kube-api-linter/blob/main/docs/linters.md"
+
+// Check is a distinct check performed by a linter.
+type Check struct {
+	// Linter is the name of the linter that performs the check.
+	Linter string
+
+	// Name is the name of the check, unique within the linter.
+	Name string
+
+	// Severity is the default severity of diagnostics reported by the check.
+	Severity config.Severity
+}
+
+// New creates a new Check for the given linter.
+func New(linter, name string, severity config.Severity) Check {
+	return Check{
+		Linter:   linter,
+		Name:     name,
+		Severity: severity,
+	}
+}
+
+// ID returns the stable identifier of the check, in the form `<linter>/<check>`.
+func (c Check) ID() string {
+	return c.Linter + "/" + c.Name
+}
+
+// URL returns the documentation URL for the check.
+func (c Check) URL() string {
+	return DocsURL(c.Linter)
+}
+
+// Report reports the diagnostic, setting the category to the check ID and the URL
+// to the documentation for the check.
+func (c Check) Report(pass *analysis.Pass, diag analysis.Diagnostic) {
+	diag.Category = c.ID()
+	diag.URL = c.URL()
+
+	pass.Report(diag)
+}
+
+// Reportf reports a diagnostic with the formatted message at the given position.
+func (c Check) Reportf(pass *analysis.Pass, pos token.Pos, format string, args ...any) {
+	c.Report(pass, analysis.Diagnostic{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// DocsURL returns the documentation URL for the given linter.
+func DocsURL(linter string) string {
+	return docsURL + "#" + linter
+}
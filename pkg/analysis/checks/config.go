@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package checks
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+// Config allows the individual checks within a linter to be enabled or disabled.
+// It is intended to be embedded within the configuration of linters that perform several checks,
+// under the `checks` key.
+type Config struct {
+	// enable is a list of checks to enable.
+	// Checks are referred to by their name within the linter, e.g. `missing-listtype`.
+	// All checks are enabled by default, so this is only required when `disable` is set to `*`.
+	// To enable all checks, set this to `*`.
+	Enable []string `json:"enable"`
+
+	// disable is a list of checks to disable.
+	// Checks are referred to by their name within the linter, e.g. `missing-listtype`.
+	// To disable all checks, set this to `*`.
+	// When all checks are disabled, checks listed in `enable` remain enabled.
+	Disable []string `json:"disable"`
+}
+
+// Enabled returns whether the check is enabled by the configuration.
+func (c Config) Enabled(check Check) bool {
+	return c.enabledByName(check.Name)
+}
+
+func (c Config) enabledByName(name string) bool {
+	enabled := sets.New(c.Enable...)
+	disabled := sets.New(c.Disable...)
+
+	if disabled.Has(name) {
+		return false
+	}
+
+	allDisabled := disabled.Len() == 1 && disabled.Has(config.Wildcard)
+	allEnabled := enabled.Len() == 1 && enabled.Has(config.Wildcard)
+
+	return !allDisabled || allEnabled || enabled.Has(name)
+}
+
+// Filter returns a copy of the pass that drops any diagnostics reported by checks
+// that are disabled by the configuration.
+// Diagnostics that were not reported via a check are always reported.
+func (c Config) Filter(pass *analysis.Pass) *analysis.Pass {
+	if len(c.Enable) == 0 && len(c.Disable) == 0 {
+		return pass
+	}
+
+	p := *pass
+	p.Report = func(diag analysis.Diagnostic) {
+		if linter, name, ok := strings.Cut(diag.Category, "/"); ok && linter == pass.Analyzer.Name && !c.enabledByName(name) {
+			return
+		}
+
+		pass.Report(diag)
+	}
+
+	return &p
+}
+
+// ValidateConfig validates the checks configuration for the named linter.
+// The checks referred to by the configuration must be registered with the DefaultRegistry.
+func ValidateConfig(c Config, linter string, fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	known := sets.New[string]()
+	for _, check := range DefaultRegistry().ForLinter(linter) {
+		known.Insert(check.Name)
+	}
+
+	enable := sets.New(c.Enable...)
+	fieldErrors = append(fieldErrors, validateCheckNames(c.Enable, known, fldPath.Child("enable"))...)
+
+	disable := sets.New(c.Disable...)
+	fieldErrors = append(fieldErrors, validateCheckNames(c.Disable, known, fldPath.Child("disable"))...)
+
+	if overlap := enable.Intersection(disable); overlap.Len() > 0 {
+		fieldErrors = append(fieldErrors, field.Invalid(fldPath, c, fmt.Sprintf("values in 'enable' and 'disable' may not overlap, overlapping values: %s", strings.Join(sets.List(overlap), ","))))
+	}
+
+	return fieldErrors
+}
+
+// validateCheckNames validates that the names are unique, known checks, or a lone wildcard.
+func validateCheckNames(names []string, known sets.Set[string], fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+	values := sets.New(names...)
+
+	switch {
+	case len(values) != len(names):
+		fieldErrors = append(fieldErrors, field.Invalid(fldPath, names, "values must be unique"))
+	case values.Has(config.Wildcard) && values.Len() != 1:
+		fieldErrors = append(fieldErrors, field.Invalid(fldPath, names, "wildcard ('*') must not be specified with other values"))
+	case !values.Has(config.Wildcard) && values.Difference(known).Len() > 0:
+		fieldErrors = append(fieldErrors, field.Invalid(fldPath, names, fmt.Sprintf("unknown checks: %s, must be one of: %s", strings.Join(sets.List(values.Difference(known)), ","), strings.Join(sets.List(known), ","))))
+	}
+
+	return fieldErrors
+}
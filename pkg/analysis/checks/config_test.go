@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package checks_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+)
+
+func TestConfigEnabled(t *testing.T) {
+	testcases := []struct {
+		name     string
+		config   checks.Config
+		expected map[checks.Check]bool
+	}{
+		{
+			name:   "empty config enables all checks",
+			config: checks.Config{},
+			expected: map[checks.Check]bool{
+				errorCheck:   true,
+				warningCheck: true,
+			},
+		},
+		{
+			name: "disabling a check",
+			config: checks.Config{
+				Disable: []string{"error-check"},
+			},
+			expected: map[checks.Check]bool{
+				errorCheck:   false,
+				warningCheck: true,
+			},
+		},
+		{
+			name: "disabling all checks",
+			config: checks.Config{
+				Disable: []string{"*"},
+			},
+			expected: map[checks.Check]bool{
+				errorCheck:   false,
+				warningCheck: false,
+			},
+		},
+		{
+			name: "disabling all checks and enabling one",
+			config: checks.Config{
+				Enable:  []string{"warning-check"},
+				Disable: []string{"*"},
+			},
+			expected: map[checks.Check]bool{
+				errorCheck:   false,
+				warningCheck: true,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for check, expected := range tc.expected {
+				if enabled := tc.config.Enabled(check); enabled != expected {
+					t.Errorf("expected check %s enabled to be %t, got %t", check.ID(), expected, enabled)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	checks.DefaultRegistry().Register(errorCheck, warningCheck)
+
+	testcases := []struct {
+		name        string
+		config      checks.Config
+		expectedErr string
+	}{
+		{
+			name:   "empty config",
+			config: checks.Config{},
+		},
+		{
+			name: "known checks",
+			config: checks.Config{
+				Enable:  []string{"warning-check"},
+				Disable: []string{"*"},
+			},
+		},
+		{
+			name: "unknown check",
+			config: checks.Config{
+				Disable: []string{"unknown"},
+			},
+			expectedErr: "checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: error-check,warning-check",
+		},
+		{
+			name: "wildcard with other values",
+			config: checks.Config{
+				Enable: []string{"*", "error-check"},
+			},
+			expectedErr: "checks.enable: Invalid value: []string{\"*\", \"error-check\"}: wildcard ('*') must not be specified with other values",
+		},
+		{
+			name: "overlapping values",
+			config: checks.Config{
+				Enable:  []string{"error-check"},
+				Disable: []string{"error-check"},
+			},
+			expectedErr: "checks: Invalid value: checks.Config{Enable:[]string{\"error-check\"}, Disable:[]string{\"error-check\"}}: values in 'enable' and 'disable' may not overlap, overlapping values: error-check",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := checks.ValidateConfig(tc.config, "testlinter", field.NewPath("checks"))
+
+			switch {
+			case tc.expectedErr == "" && len(errs) > 0:
+				t.Errorf("expected no errors, got %v", errs.ToAggregate())
+			case tc.expectedErr != "" && (len(errs) == 0 || errs.ToAggregate().Error() != tc.expectedErr):
+				t.Errorf("expected error %q, got %v", tc.expectedErr, errs.ToAggregate())
+			}
+		})
+	}
+}
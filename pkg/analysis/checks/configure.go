@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package checks
+
+import (
+	"fmt"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+// DefaultSeverity is the severity applied to diagnostics that are not reported via a registered check.
+const DefaultSeverity = config.SeverityWarning
+
+// Configure wraps each of the analyzers so that the diagnostics they report
+// have the configured severity applied, and so that disabled checks are not reported.
+// The check ID and severity are appended to each diagnostic message, as golangci-lint
+// does not otherwise surface the diagnostic category.
+// The original analyzers are not modified.
+func Configure(analyzers []*analysis.Analyzer, cfg config.Diagnostics) []*analysis.Analyzer {
+	return ConfigureWithRegistry(analyzers, cfg, DefaultRegistry())
+}
+
+// ConfigureWithRegistry is the same as Configure but uses the provided registry
+// to determine the default severity of each check.
+func ConfigureWithRegistry(analyzers []*analysis.Analyzer, cfg config.Diagnostics, registry Registry) []*analysis.Analyzer {
+	r := &reporter{
+		registry: registry,
+		severity: cfg.Severity,
+		disabled: sets.New(cfg.Disable...),
+	}
+
+	out := make([]*analysis.Analyzer, 0, len(analyzers))
+
+	for _, a := range analyzers {
+		out = append(out, r.wrap(a))
+	}
+
+	return out
+}
+
+type reporter struct {
+	registry Registry
+	severity map[string]config.Severity
+	disabled sets.Set[string]
+}
+
+// wrap returns a copy of the analyzer whose pass reports diagnostics via the reporter.
+func (r *reporter) wrap(a *analysis.Analyzer) *analysis.Analyzer {
+	wrapped := *a
+	run := a.Run
+
+	wrapped.Run = func(pass *analysis.Pass) (any, error) {
+		p := *pass
+		p.Report = func(diag analysis.Diagnostic) {
+			diag, ok := r.configure(a.Name, diag)
+			if !ok {
+				return
+			}
+
+			pass.Report(diag)
+		}
+
+		return run(&p)
+	}
+
+	return &wrapped
+}
+
+// configure applies the configuration to the diagnostic.
+// It returns false when the check that reported the diagnostic is disabled.
+func (r *reporter) configure(linter string, diag analysis.Diagnostic) (analysis.Diagnostic, bool) {
+	if diag.Category == "" {
+		diag.Category = linter
+	}
+
+	if r.disabled.Has(diag.Category) {
+		return diag, false
+	}
+
+	if diag.URL == "" {
+		diag.URL = DocsURL(linter)
+	}
+
+	diag.Message = fmt.Sprintf("%s [%s %s]", diag.Message, r.severityFor(linter, diag.Category), diag.Category)
+
+	return diag, true
+}
+
+// severityFor returns the severity for the check with the given ID.
+// Configuration for the check ID takes precedence over configuration for the linter,
+// which in turn takes precedence over the default severity of the check.
+func (r *reporter) severityFor(linter, id string) config.Severity {
+	if severity, ok := r.severity[id]; ok {
+		return severity
+	}
+
+	if severity, ok := r.severity[linter]; ok {
+		return severity
+	}
+
+	if check, ok := r.registry.Get(id); ok && check.Severity != "" {
+		return check.Severity
+	}
+
+	return DefaultSeverity
+}
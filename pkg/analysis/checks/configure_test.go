@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package checks_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+var (
+	errorCheck   = checks.New("testlinter", "error-check", config.SeverityError)
+	warningCheck = checks.New("testlinter", "warning-check", config.SeverityWarning)
+)
+
+// testAnalyzer reports every struct field using the check matching the field name.
+// Fields named Other are reported directly via the pass.
+func testAnalyzer() *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name: "testlinter",
+		Doc:  "reports struct fields",
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				ast.Inspect(file, func(n ast.Node) bool {
+					field, ok := n.(*ast.Field)
+					if !ok || len(field.Names) == 0 {
+						return true
+					}
+
+					switch field.Names[0].Name {
+					case "Error":
+						errorCheck.Reportf(pass, field.Pos(), "field %s", field.Names[0].Name)
+					case "Warning":
+						warningCheck.Reportf(pass, field.Pos(), "field %s", field.Names[0].Name)
+					default:
+						pass.Reportf(field.Pos(), "field %s", field.Names[0].Name)
+					}
+
+					return true
+				})
+			}
+
+			return nil, nil //nolint:nilnil
+		},
+	}
+}
+
+func newRegistry() checks.Registry {
+	registry := checks.NewRegistry()
+	registry.Register(errorCheck, warningCheck)
+
+	return registry
+}
+
+func TestConfigureDefaults(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzers := checks.ConfigureWithRegistry([]*analysis.Analyzer{testAnalyzer()}, config.Diagnostics{}, newRegistry())
+
+	analysistest.Run(t, testdata, analyzers[0], "a")
+}
+
+func TestConfigureOverrides(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzers := checks.ConfigureWithRegistry([]*analysis.Analyzer{testAnalyzer()}, config.Diagnostics{
+		Severity: map[string]config.Severity{
+			"testlinter":               config.SeverityInfo,
+			"testlinter/warning-check": config.SeverityError,
+		},
+		Disable: []string{"testlinter/error-check"},
+	}, newRegistry())
+
+	analysistest.Run(t, testdata, analyzers[0], "b")
+}
+
+func TestCheckReportSetsCategoryAndURL(t *testing.T) {
+	var diags []analysis.Diagnostic
+
+	pass := &analysis.Pass{
+		Report: func(d analysis.Diagnostic) {
+			diags = append(diags, d)
+		},
+	}
+
+	errorCheck.Reportf(pass, 0, "message")
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+
+	if diags[0].Category != "testlinter/error-check" {
+		t.Errorf("expected category %q, got %q", "testlinter/error-check", diags[0].Category)
+	}
+
+	if want := "https://github.com/kubernetes-sigs/kube-api-linter/blob/main/docs/linters.md#testlinter"; diags[0].URL != want {
+		t.Errorf("expected URL %q, got %q", want, diags[0].URL)
+	}
+}
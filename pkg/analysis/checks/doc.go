@@ -0,0 +1,41 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+checks provides stable identifiers and default severities for the individual checks performed by each linter.
+
+Many linters perform several distinct checks, for example, the `ssatags` linter checks both for missing
+`listType` markers and for invalid `listType` values.
+Each of these checks is given an ID of the form `<linter>/<check>`, e.g. `ssatags/missing-listtype`,
+which remains stable across releases and can be used to refer to the check within configuration.
+
+Linters declare their checks with New and register them with the DefaultRegistry during an init() function.
+Diagnostics should then be reported via the Check, which sets the diagnostic category to the check ID
+and the URL to the documentation for the linter:
+
+	var missingListTypeCheck = checks.New(name, "missing-listtype", config.SeverityWarning)
+
+	func init() {
+		checks.DefaultRegistry().Register(missingListTypeCheck)
+	}
+
+	missingListTypeCheck.Reportf(pass, field.Pos(), "%s should have a listType marker", fieldName)
+
+When running as a plugin, Configure wraps the analyzers so that the configured severities are applied,
+disabled checks are dropped, and the check ID and severity are appended to each diagnostic message.
+Diagnostics reported directly via the pass, without a check, are identified by the linter name.
+*/
+package checks
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package checks
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// Registry is a thread-safe set of known checks.
+type Registry interface {
+	// Register adds the provided checks to the Registry.
+	Register(checks ...Check)
+
+	// Get returns the check with the given ID, and whether it was found.
+	Get(id string) (Check, bool)
+
+	// All returns all registered checks, sorted by ID.
+	All() []Check
+
+	// ForLinter returns the registered checks for the named linter, sorted by ID.
+	ForLinter(linter string) []Check
+}
+
+var defaultRegistry = NewRegistry() //nolint:gochecknoglobals
+
+// DefaultRegistry is a global registry for known checks.
+// Linters should register the checks they perform during an init() function.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
+
+type registry struct {
+	checks map[string]Check
+	mu     sync.RWMutex
+}
+
+// NewRegistry creates a new Registry.
+func NewRegistry() Registry {
+	return &registry{
+		checks: map[string]Check{},
+	}
+}
+
+// Register adds the provided checks to the Registry.
+func (r *registry) Register(checks ...Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, check := range checks {
+		r.checks[check.ID()] = check
+	}
+}
+
+// Get returns the check with the given ID, and whether it was found.
+func (r *registry) Get(id string) (Check, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	check, ok := r.checks[id]
+
+	return check, ok
+}
+
+// All returns all registered checks, sorted by ID.
+func (r *registry) All() []Check {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]Check, 0, len(r.checks))
+	for _, check := range r.checks {
+		out = append(out, check)
+	}
+
+	slices.SortFunc(out, func(a, b Check) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return out
+}
+
+// ForLinter returns the registered checks for the named linter, sorted by ID.
+func (r *registry) ForLinter(linter string) []Check {
+	out := []Check{}
+
+	for _, check := range r.All() {
+		if check.Linter == linter {
+			out = append(out, check)
+		}
+	}
+
+	return out
+}
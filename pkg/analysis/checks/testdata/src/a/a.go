@@ -0,0 +1,9 @@
+package a
+
+type A struct {
+	Error string // want `field Error \[Error testlinter/error-check\]`
+
+	Warning string // want `field Warning \[Warning testlinter/warning-check\]`
+
+	Other string // want `field Other \[Warning testlinter\]`
+}
@@ -0,0 +1,9 @@
+package b
+
+type B struct {
+	Error string
+
+	Warning string // want `field Warning \[Error testlinter/warning-check\]`
+
+	Other string // want `field Other \[Info testlinter\]`
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package collections
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+const name = "collections"
+
+//nolint:gochecknoglobals
+var (
+	nestedArrayCheck         = checks.New(name, "nested-array", config.SeverityWarning)
+	pointerToCollectionCheck = checks.New(name, "pointer-to-collection", config.SeverityWarning)
+	mapKeyCheck              = checks.New(name, "map-key", config.SeverityWarning)
+	nestedMapValueCheck      = checks.New(name, "nested-map-value", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(nestedArrayCheck, pointerToCollectionCheck, mapKeyCheck, nestedMapValueCheck)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *CollectionsConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &CollectionsConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that arrays and maps within API types are not nested, are not pointers, and that maps are keyed by strings.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	typeChecker := utils.NewTypeChecker(isInvalidCollection, reportInvalidCollection)
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, _ markers.Markers, _ string) {
+		typeChecker.CheckNode(pass, field)
+	})
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, _ markers.Markers) {
+		typeChecker.CheckNode(pass, typeSpec)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// isInvalidCollection determines whether the expression is an array, map or pointer
+// that should be reported.
+// The type checker stops at the first invalid collection, so only the outermost
+// problem within a nested type expression is reported.
+func isInvalidCollection(pass *analysis.Pass, expr ast.Expr) bool {
+	switch typ := expr.(type) {
+	case *ast.ArrayType:
+		return isNestedArray(pass, typ)
+	case *ast.StarExpr:
+		return isSliceOrMap(pass.TypesInfo.TypeOf(typ.X))
+	case *ast.MapType:
+		return !isStringKey(pass, typ) || isCollection(pass.TypesInfo.TypeOf(typ.Value))
+	}
+
+	return false
+}
+
+func reportInvalidCollection(pass *analysis.Pass, expr ast.Expr, node ast.Node, prefix string) {
+	switch typ := expr.(type) {
+	case *ast.ArrayType:
+		nestedArrayCheck.Reportf(pass, node.Pos(), "%s should not be a nested array (%s). Server-side apply cannot merge nested arrays, use an array of objects containing an array instead", prefix, types.ExprString(typ))
+	case *ast.StarExpr:
+		reportPointerToCollection(pass, typ, node, prefix)
+	case *ast.MapType:
+		if !isStringKey(pass, typ) {
+			mapKeyCheck.Reportf(pass, node.Pos(), "%s should not be a map with non-string keys (%s). Map keys are serialized as strings, use a map with string keys instead", prefix, types.ExprString(typ))
+		}
+
+		if isCollection(pass.TypesInfo.TypeOf(typ.Value)) {
+			nestedMapValueCheck.Reportf(pass, node.Pos(), "%s should not be a map with array or map values (%s). Use an array of objects, keyed by name, instead", prefix, types.ExprString(typ))
+		}
+	}
+}
+
+// reportPointerToCollection reports a pointer to an array or map.
+// Where the pointer is part of the type expression of the reported node, a fix is suggested to remove the pointer.
+// Pointers within aliases are fixed where the alias is declared.
+func reportPointerToCollection(pass *analysis.Pass, star *ast.StarExpr, node ast.Node, prefix string) {
+	diag := analysis.Diagnostic{
+		Pos:     node.Pos(),
+		Message: fmt.Sprintf("%s should not be a pointer to a slice or map (%s). Slices and maps are already nil-able, and should not be pointers", prefix, types.ExprString(star)),
+	}
+
+	if node.Pos() <= star.Pos() && star.End() <= node.End() {
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: "remove the pointer",
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     star.Star,
+						End:     star.X.Pos(),
+						NewText: nil,
+					},
+				},
+			},
+		}
+	}
+
+	pointerToCollectionCheck.Report(pass, diag)
+}
+
+// isNestedArray determines whether the array has elements that are themselves arrays.
+// Byte arrays are serialized as base64 encoded strings, and so arrays of byte arrays are allowed.
+func isNestedArray(pass *analysis.Pass, arrayType *ast.ArrayType) bool {
+	elt := pass.TypesInfo.TypeOf(arrayType.Elt)
+	if elt == nil {
+		return false
+	}
+
+	switch u := elt.Underlying().(type) {
+	case *types.Slice:
+		return !isByte(u.Elem())
+	case *types.Array:
+		return !isByte(u.Elem())
+	}
+
+	return false
+}
+
+// isCollection determines whether the type is an array or map, following aliases to the underlying type.
+// Byte arrays are serialized as base64 encoded strings, and so are not considered to be collections.
+func isCollection(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	switch u := typ.Underlying().(type) {
+	case *types.Slice:
+		return !isByte(u.Elem())
+	case *types.Array:
+		return !isByte(u.Elem())
+	case *types.Map:
+		return true
+	}
+
+	return false
+}
+
+// isSliceOrMap determines whether the type is a slice or map, following aliases to the underlying type.
+// Slices and maps are nil-able, and so do not need to be pointers.
+func isSliceOrMap(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	switch typ.Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return true
+	}
+
+	return false
+}
+
+// isStringKey determines whether the map is keyed by a string, or by a type based on a string.
+func isStringKey(pass *analysis.Pass, mapType *ast.MapType) bool {
+	key := pass.TypesInfo.TypeOf(mapType.Key)
+	if key == nil {
+		return true
+	}
+
+	basic, ok := key.Underlying().(*types.Basic)
+
+	return ok && basic.Info()&types.IsString != 0
+}
+
+func isByte(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+
+	return ok && basic.Kind() == types.Byte
+}
@@ -0,0 +1,29 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `collections` linter checks that arrays and maps within API types are simple, single level collections.
+
+Server-side apply and OpenAPI handle nested collections poorly, so the linter reports:
+  - Nested arrays, e.g. `[][]string`. Arrays of byte arrays are allowed, as byte arrays are serialized as strings.
+  - Pointers to slices and maps, e.g. `*[]string` or `*map[string]string`. Slices and maps are already nil-able.
+  - Maps with keys that are not strings, e.g. `map[int]string`. Map keys are always serialized as strings.
+  - Maps with array or map values, e.g. `map[string][]string`.
+
+The linter follows type aliases, so that `[]StringList`, where `StringList` is a `[]string`, is reported as a nested array.
+
+Where a pointer to a slice or map is used directly by a field or type, the linter suggests a fix to remove the pointer.
+*/
+package collections
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package collections_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/collections"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+)
+
+var _ = Describe("collections initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      collections.CollectionsConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := collections.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("collections"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid CollectionsConfig", testCase{
+				config:      collections.CollectionsConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid CollectionsConfig: Checks: disable nested-array", testCase{
+				config: collections.CollectionsConfig{
+					Checks: checks.Config{
+						Disable: []string{"nested-array"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid CollectionsConfig: Checks: unknown check", testCase{
+				config: collections.CollectionsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "collections.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: map-key,nested-array,nested-map-value,pointer-to-collection",
+			}),
+		)
+	})
+})
@@ -0,0 +1,52 @@
+package a
+
+type CollectionsTestStruct struct {
+	// Strings is a valid array.
+	Strings []string `json:"strings"`
+
+	// Labels is a valid map.
+	Labels map[string]string `json:"labels"`
+
+	// Certificates is an array of byte arrays, which serialize as strings.
+	Certificates [][]byte `json:"certificates"`
+
+	// Data is a map of byte arrays, which serialize as strings.
+	Data map[string][]byte `json:"data"`
+
+	// Keys uses a string based key.
+	Keys map[KeyName]string `json:"keys"`
+
+	Matrix [][]string `json:"matrix"` // want "field CollectionsTestStruct.Matrix should not be a nested array \\(\\[\\]\\[\\]string\\). Server-side apply cannot merge nested arrays, use an array of objects containing an array instead"
+
+	Cube [][][]int32 `json:"cube"` // want "field CollectionsTestStruct.Cube should not be a nested array \\(\\[\\]\\[\\]\\[\\]int32\\)"
+
+	AliasMatrix []StringList `json:"aliasMatrix"` // want "field CollectionsTestStruct.AliasMatrix should not be a nested array \\(\\[\\]StringList\\)"
+
+	StringListPtr *[]string `json:"stringListPtr"` // want "field CollectionsTestStruct.StringListPtr should not be a pointer to a slice or map \\(\\*\\[\\]string\\). Slices and maps are already nil-able, and should not be pointers"
+
+	MapPtr *map[string]string `json:"mapPtr"` // want "field CollectionsTestStruct.MapPtr should not be a pointer to a slice or map \\(\\*map\\[string\\]string\\)"
+
+	AliasPtr *StringList `json:"aliasPtr"` // want "field CollectionsTestStruct.AliasPtr should not be a pointer to a slice or map \\(\\*StringList\\)"
+
+	ArrayOfPtrs []*[]string `json:"arrayOfPtrs"` // want "field CollectionsTestStruct.ArrayOfPtrs array element should not be a pointer to a slice or map \\(\\*\\[\\]string\\)"
+
+	IntKeys map[int]string `json:"intKeys"` // want "field CollectionsTestStruct.IntKeys should not be a map with non-string keys \\(map\\[int\\]string\\). Map keys are serialized as strings, use a map with string keys instead"
+
+	MapOfArrays map[string][]string `json:"mapOfArrays"` // want "field CollectionsTestStruct.MapOfArrays should not be a map with array or map values \\(map\\[string\\]\\[\\]string\\). Use an array of objects, keyed by name, instead"
+
+	MapOfMaps map[string]map[string]string `json:"mapOfMaps"` // want "field CollectionsTestStruct.MapOfMaps should not be a map with array or map values"
+
+	IntKeyedMapOfArrays map[int32][]string `json:"intKeyedMapOfArrays"` // want "field CollectionsTestStruct.IntKeyedMapOfArrays should not be a map with non-string keys" "field CollectionsTestStruct.IntKeyedMapOfArrays should not be a map with array or map values"
+
+	Alias NestedAlias `json:"alias"` // want "field CollectionsTestStruct.Alias type NestedAlias should not be a nested array"
+
+	PointerAlias PointerToList `json:"pointerAlias"` // want "field CollectionsTestStruct.PointerAlias type PointerToList should not be a pointer to a slice or map"
+}
+
+type KeyName string
+
+type StringList []string
+
+type NestedAlias [][]string // want "type NestedAlias should not be a nested array"
+
+type PointerToList *[]string // want "type PointerToList should not be a pointer to a slice or map"
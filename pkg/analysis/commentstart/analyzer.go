@@ -22,14 +22,26 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "commentstart"
 
+//nolint:gochecknoglobals
+var (
+	missingGodocCheck = checks.New(name, "missing-godoc", config.SeverityWarning)
+	fieldNameCheck    = checks.New(name, "field-name", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(missingGodocCheck, fieldNameCheck)
+}
+
 // Analyzer is the analyzer for the commentstart package.
 // It checks that all struct fields in an API have a godoc, and that the godoc starts with the serialised field name.
 var Analyzer = &analysis.Analyzer{
@@ -58,7 +70,7 @@ func checkField(pass *analysis.Pass, field *ast.Field, tagInfo extractjsontags.F
 	}
 
 	if field.Doc == nil {
-		pass.Reportf(field.Pos(), "field %s is missing godoc comment", qualifiedFieldName)
+		missingGodocCheck.Reportf(pass, field.Pos(), "field %s is missing godoc comment", qualifiedFieldName)
 		return
 	}
 
@@ -66,7 +78,7 @@ func checkField(pass *analysis.Pass, field *ast.Field, tagInfo extractjsontags.F
 	if !strings.HasPrefix(firstLine.Text, "// "+tagInfo.Name+" ") {
 		if strings.HasPrefix(strings.ToLower(firstLine.Text), strings.ToLower("// "+tagInfo.Name+" ")) {
 			// The comment start is correct, apart from the casing, we can fix that.
-			pass.Report(analysis.Diagnostic{
+			fieldNameCheck.Report(pass, analysis.Diagnostic{
 				Pos:     firstLine.Pos(),
 				Message: fmt.Sprintf("godoc for field %s should start with '%s ...'", qualifiedFieldName, tagInfo.Name),
 				SuggestedFixes: []analysis.SuggestedFix{
@@ -83,7 +95,7 @@ func checkField(pass *analysis.Pass, field *ast.Field, tagInfo extractjsontags.F
 				},
 			})
 		} else {
-			pass.Reportf(field.Doc.List[0].Pos(), "godoc for field %s should start with '%s ...'", qualifiedFieldName, tagInfo.Name)
+			fieldNameCheck.Reportf(pass, field.Doc.List[0].Pos(), "godoc for field %s should start with '%s ...'", qualifiedFieldName, tagInfo.Name)
 		}
 	}
 }
@@ -18,16 +18,24 @@ package conditions
 import (
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/token"
+	"go/types"
+	"regexp"
 	"slices"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	kalinspector "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const (
@@ -49,9 +57,46 @@ const (
 	expectedJSONTag     = "json:\"conditions,omitempty\""
 	expectedPatchTag    = "patchStrategy:\"merge\" patchMergeKey:\"type\""
 	expectedProtobufTag = "protobuf:\"bytes,%d,rep,name=conditions\""
+
+	metav1Path          = "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1Condition     = "Condition"
+	conditionTypeSuffix = "ConditionType"
+	conditionSuffix     = "Condition"
+	statusPathPrefix    = "status."
+)
+
+// requiredConditionFields are the JSON fields that a condition type must have.
+//
+//nolint:gochecknoglobals
+var requiredConditionFields = []string{"type", "status", "reason", "message", "lastTransitionTime"}
+
+// pascalCaseRegex matches PascalCase condition types, e.g. `Ready` or `PodScheduled`.
+var pascalCaseRegex = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+
+//nolint:gochecknoglobals
+var (
+	invalidTypeCheck       = checks.New(name, "invalid-type", config.SeverityError)
+	missingMarkersCheck    = checks.New(name, "missing-markers", config.SeverityWarning)
+	additionalMarkersCheck = checks.New(name, "additional-markers", config.SeverityWarning)
+	missingTagsCheck       = checks.New(name, "missing-tags", config.SeverityWarning)
+	incorrectTagsCheck     = checks.New(name, "incorrect-tags", config.SeverityWarning)
+	firstFieldCheck        = checks.New(name, "first-field", config.SeverityWarning)
+	typeValueCheck         = checks.New(name, "type-value", config.SeverityWarning)
+	untypedTypeCheck       = checks.New(name, "untyped-type", config.SeverityWarning)
 )
 
 func init() {
+	checks.DefaultRegistry().Register(
+		invalidTypeCheck,
+		missingMarkersCheck,
+		additionalMarkersCheck,
+		missingTagsCheck,
+		incorrectTagsCheck,
+		firstFieldCheck,
+		typeValueCheck,
+		untypedTypeCheck,
+	)
+
 	markers.DefaultRegistry().Register(
 		listTypeMarkerID,
 		listMapKeyMarkerID,
@@ -62,9 +107,11 @@ func init() {
 }
 
 type analyzer struct {
-	isFirstField     ConditionsFirstField
-	useProtobuf      ConditionsUseProtobuf
-	usePatchStrategy ConditionsUsePatchStrategy
+	isFirstField             ConditionsFirstField
+	useProtobuf              ConditionsUseProtobuf
+	usePatchStrategy         ConditionsUsePatchStrategy
+	additionalConditionTypes []string
+	checks                   checks.Config
 }
 
 // newAnalyzer creates a new analyzer.
@@ -76,20 +123,24 @@ func newAnalyzer(cfg *ConditionsConfig) *analysis.Analyzer {
 	defaultConfig(cfg)
 
 	a := &analyzer{
-		isFirstField:     cfg.IsFirstField,
-		useProtobuf:      cfg.UseProtobuf,
-		usePatchStrategy: cfg.UsePatchStrategy,
+		checks:                   cfg.Checks,
+		isFirstField:             cfg.IsFirstField,
+		useProtobuf:              cfg.UseProtobuf,
+		usePatchStrategy:         cfg.UsePatchStrategy,
+		additionalConditionTypes: cfg.AdditionalConditionTypes,
 	}
 
 	return &analysis.Analyzer{
 		Name:     name,
 		Doc:      `Checks that all conditions type fields conform to the required conventions.`,
 		Run:      a.run,
-		Requires: []*analysis.Analyzer{inspect.Analyzer, markers.Analyzer, extractjsontags.Analyzer},
+		Requires: []*analysis.Analyzer{inspect.Analyzer, kalinspector.Analyzer, markers.Analyzer, extractjsontags.Analyzer},
 	}
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -100,11 +151,25 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 		return nil, kalerrors.ErrCouldNotGetMarkers
 	}
 
+	fieldPaths, ok := pass.ResultOf[kalinspector.Analyzer].(kalinspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
 	nodeFilter := []ast.Node{
 		(*ast.TypeSpec)(nil),
+		(*ast.ValueSpec)(nil),
 	}
 
 	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		if vSpec, ok := n.(*ast.ValueSpec); ok {
+			for _, ident := range vSpec.Names {
+				checkConditionTypeConstant(pass, ident)
+			}
+
+			return
+		}
+
 		tSpec, ok := n.(*ast.TypeSpec)
 		if !ok {
 			return
@@ -124,20 +189,20 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 		for i, field := range sTyp.Fields.List {
 			fieldMarkers := markersAccess.FieldMarkers(field)
 
-			a.checkField(pass, i, field, fieldMarkers, structName)
+			a.checkField(pass, fieldPaths, i, field, fieldMarkers, structName)
 		}
 	})
 
 	return nil, nil //nolint:nilnil
 }
 
-func (a *analyzer) checkField(pass *analysis.Pass, index int, field *ast.Field, fieldMarkers markers.MarkerSet, structName string) {
+func (a *analyzer) checkField(pass *analysis.Pass, fieldPaths kalinspector.Inspector, index int, field *ast.Field, fieldMarkers markers.MarkerSet, structName string) {
 	if !fieldIsCalledConditions(field) {
+		a.checkNestedField(pass, fieldPaths, field, fieldMarkers, structName)
 		return
 	}
 
-	if !isSliceMetaV1Condition(field) {
-		pass.Reportf(field.Pos(), "Conditions field in %s must be a slice of metav1.Condition", structName)
+	if !a.isConditionsSlice(pass, field, structName) {
 		return
 	}
 
@@ -145,10 +210,121 @@ func (a *analyzer) checkField(pass *analysis.Pass, index int, field *ast.Field,
 	a.checkFieldTags(pass, index, field, structName)
 
 	if a.isFirstField == ConditionsFirstFieldWarn && index != 0 {
-		pass.Reportf(field.Pos(), "Conditions field in %s must be the first field in the struct", structName)
+		firstFieldCheck.Reportf(pass, field.Pos(), "Conditions field in %s must be the first field in the struct", structName)
 	}
 }
 
+// checkNestedField checks the markers of slices of conditions that are not called Conditions,
+// but are found under the status of a root object, for example, the conditions of each item within a list.
+// The JSON tags and placement of these fields are not checked, as they are not the conditions of the object itself.
+func (a *analyzer) checkNestedField(pass *analysis.Pass, fieldPaths kalinspector.Inspector, field *ast.Field, fieldMarkers markers.MarkerSet, structName string) {
+	conditionType, ok := sliceElemNamedType(pass, field)
+	if !ok || !(isMetaV1Condition(conditionType) || a.isAdditionalConditionType(pass, conditionType)) {
+		return
+	}
+
+	if !slices.ContainsFunc(fieldPaths.FieldPaths(field), func(path kalinspector.FieldPath) bool {
+		return strings.HasPrefix(path.Path, statusPathPrefix)
+	}) {
+		return
+	}
+
+	if !hasConditionFields(pass, field, conditionType, structName) {
+		return
+	}
+
+	checkFieldMarkers(pass, field, fieldMarkers, a.usePatchStrategy, structName)
+}
+
+// isConditionsSlice checks that the Conditions field is a slice of metav1.Condition,
+// or of one of the additional condition types.
+func (a *analyzer) isConditionsSlice(pass *analysis.Pass, field *ast.Field, structName string) bool {
+	if isSliceMetaV1Condition(field) {
+		return true
+	}
+
+	if conditionType, ok := sliceElemNamedType(pass, field); ok && a.isAdditionalConditionType(pass, conditionType) {
+		return hasConditionFields(pass, field, conditionType, structName)
+	}
+
+	if len(a.additionalConditionTypes) == 0 {
+		invalidTypeCheck.Reportf(pass, field.Pos(), "Conditions field in %s must be a slice of metav1.Condition", structName)
+	} else {
+		invalidTypeCheck.Reportf(pass, field.Pos(), "Conditions field in %s must be a slice of metav1.Condition, or of one of the additional condition types: %s", structName, strings.Join(a.additionalConditionTypes, ", "))
+	}
+
+	return false
+}
+
+// isAdditionalConditionType determines whether the type is one of the configured additional condition types.
+func (a *analyzer) isAdditionalConditionType(pass *analysis.Pass, named *types.Named) bool {
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(a.additionalConditionTypes, func(conditionType string) bool {
+		return conditionType == obj.Pkg().Path()+"."+obj.Name() || (conditionType == obj.Name() && obj.Pkg() == pass.Pkg)
+	})
+}
+
+// hasConditionFields checks that the condition type has the JSON fields required of a condition.
+func hasConditionFields(pass *analysis.Pass, field *ast.Field, conditionType *types.Named, structName string) bool {
+	conditionFields := map[string]*types.Var{}
+	if sTyp, ok := conditionType.Underlying().(*types.Struct); ok {
+		conditionFields = utils.SerializedStructFields(sTyp)
+	}
+
+	missingFields := []string{}
+
+	for _, fieldName := range requiredConditionFields {
+		if _, ok := conditionFields[fieldName]; !ok {
+			missingFields = append(missingFields, fieldName)
+		}
+	}
+
+	if len(missingFields) != 0 {
+		invalidTypeCheck.Reportf(pass, field.Pos(), "Conditions field in %s uses the condition type %s, which is missing the following fields: %s", structName, types.TypeString(conditionType, types.RelativeTo(pass.Pkg)), strings.Join(missingFields, ", "))
+		return false
+	}
+
+	return true
+}
+
+// checkConditionTypeConstant checks that constants declaring condition types are typed, and have PascalCase values.
+// Constants declare condition types when they are declared at the package level, and their type is named `*ConditionType`,
+// or when they are untyped, and are named `*Condition` or `*ConditionType`.
+func checkConditionTypeConstant(pass *analysis.Pass, ident *ast.Ident) {
+	c, ok := pass.TypesInfo.Defs[ident].(*types.Const)
+	if !ok || c.Parent() != pass.Pkg.Scope() || c.Val().Kind() != constant.String {
+		return
+	}
+
+	named, isNamed := c.Type().(*types.Named)
+
+	switch {
+	case isNamed && strings.HasSuffix(named.Obj().Name(), conditionTypeSuffix):
+	case !isNamed && (strings.HasSuffix(ident.Name, conditionSuffix) || strings.HasSuffix(ident.Name, conditionTypeSuffix)):
+		untypedTypeCheck.Reportf(pass, ident.Pos(), "condition type %s should be declared as a typed constant, using a named string type such as ConditionType", ident.Name)
+	default:
+		return
+	}
+
+	if value := constant.StringVal(c.Val()); !isPascalCaseConditionType(value) {
+		typeValueCheck.Reportf(pass, ident.Pos(), "condition type %s has the value %q, condition types should be PascalCase, optionally prefixed by a DNS subdomain, e.g. example.com/Ready", ident.Name, value)
+	}
+}
+
+// isPascalCaseConditionType determines whether the condition type is PascalCase,
+// allowing for an optional DNS subdomain prefix, e.g. `example.com/Ready`.
+func isPascalCaseConditionType(value string) bool {
+	if prefix, name, ok := strings.Cut(value, "/"); ok {
+		return len(validation.IsDNS1123Subdomain(prefix)) == 0 && pascalCaseRegex.MatchString(name)
+	}
+
+	return pascalCaseRegex.MatchString(value)
+}
+
 func checkFieldMarkers(pass *analysis.Pass, field *ast.Field, fieldMarkers markers.MarkerSet, usePatchStrategy ConditionsUsePatchStrategy, structName string) {
 	missingMarkers := []string{}
 	additionalMarkers := []markers.Marker{}
@@ -234,7 +410,7 @@ func reportMissingMarkers(pass *analysis.Pass, field *ast.Field, missingMarkers
 		}
 	}
 
-	pass.Report(analysis.Diagnostic{
+	missingMarkersCheck.Report(pass, analysis.Diagnostic{
 		Pos:            field.Pos(),
 		End:            field.End(),
 		Message:        "Conditions field in " + structName + " is missing the following markers: " + strings.Join(missingMarkers, ", "),
@@ -261,7 +437,7 @@ func reportAdditionalMarkers(pass *analysis.Pass, field *ast.Field, additionalMa
 		})
 	}
 
-	pass.Report(analysis.Diagnostic{
+	additionalMarkersCheck.Report(pass, analysis.Diagnostic{
 		Pos:            field.Pos(),
 		End:            field.End(),
 		Message:        fmt.Sprintf("Conditions field in %s has the following additional markers: %s", structName, strings.Join(additionalMarkerValues, ", ")),
@@ -283,7 +459,7 @@ func (a *analyzer) checkFieldTags(pass *analysis.Pass, index int, field *ast.Fie
 	if field.Tag == nil {
 		expectedTag := getExpectedTag(a.usePatchStrategy, a.useProtobuf, a.isFirstField, index)
 
-		pass.Report(analysis.Diagnostic{
+		missingTagsCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			End:     field.End(),
 			Message: fmt.Sprintf("Conditions field in %s is missing tags, should be: %s", structName, expectedTag),
@@ -309,9 +485,9 @@ func (a *analyzer) checkFieldTags(pass *analysis.Pass, index int, field *ast.Fie
 		expectedTag := getExpectedTag(a.usePatchStrategy, a.useProtobuf, a.isFirstField, index)
 
 		if !shouldFix {
-			pass.Reportf(field.Tag.ValuePos, "Conditions field in %s has incorrect tags, should be: %s", structName, expectedTag)
+			incorrectTagsCheck.Reportf(pass, field.Tag.ValuePos, "Conditions field in %s has incorrect tags, should be: %s", structName, expectedTag)
 		} else {
-			pass.Report(analysis.Diagnostic{
+			incorrectTagsCheck.Report(pass, analysis.Diagnostic{
 				Pos:     field.Tag.ValuePos,
 				End:     field.Tag.End(),
 				Message: fmt.Sprintf("Conditions field in %s has incorrect tags, should be: %s", structName, expectedTag),
@@ -437,6 +613,25 @@ func isSliceMetaV1Condition(field *ast.Field) bool {
 	return true
 }
 
+// sliceElemNamedType returns the named element type of a slice field.
+func sliceElemNamedType(pass *analysis.Pass, field *ast.Field) (*types.Named, bool) {
+	slice, ok := pass.TypesInfo.TypeOf(field.Type).(*types.Slice)
+	if !ok {
+		return nil, false
+	}
+
+	named, ok := types.Unalias(slice.Elem()).(*types.Named)
+
+	return named, ok
+}
+
+// isMetaV1Condition determines whether the type is metav1.Condition.
+func isMetaV1Condition(named *types.Named) bool {
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == metav1Path && obj.Name() == metav1Condition
+}
+
 func defaultConfig(cfg *ConditionsConfig) {
 	if cfg.IsFirstField == "" {
 		cfg.IsFirstField = ConditionsFirstFieldWarn
@@ -97,3 +97,18 @@ func TestForbidPatchStrategy(t *testing.T) {
 
 	analysistest.RunWithSuggestedFixes(t, testdata, a, "f")
 }
+
+func TestAdditionalConditionTypes(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	a, err := conditions.Initializer().Init(&conditions.ConditionsConfig{
+		UseProtobuf:              conditions.ConditionsUseProtobufIgnore,
+		UsePatchStrategy:         conditions.ConditionsUsePatchStrategyIgnore,
+		AdditionalConditionTypes: []string{"Condition", "example.com/conditions.Condition", "IncompleteCondition"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, a, "g")
+}
@@ -15,6 +15,8 @@ limitations under the License.
 */
 package conditions
 
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
 // ConditionsFirstField is the policy for the conditions linter.
 type ConditionsFirstField string
 
@@ -86,4 +88,16 @@ type ConditionsConfig struct {
 	// When set to Forbid, the linter will emit an error if the conditions are using patch strategy tags, a fix will also be suggested.
 	// When otherwise not specified, the default value is SuggestFix.
 	UsePatchStrategy ConditionsUsePatchStrategy `json:"usePatchStrategy"`
+
+	// additionalConditionTypes is a list of types, other than metav1.Condition, that conditions may use as their element type.
+	// Each type is either the name of a type declared in the package being linted, e.g. `Condition`,
+	// or the name of a type qualified by its import path, e.g. `example.com/api/v1.Condition`.
+	// Each type must be a struct with the `type`, `status`, `reason`, `message` and `lastTransitionTime` JSON fields,
+	// for example, by embedding metav1.Condition inline.
+	AdditionalConditionTypes []string `json:"additionalConditionTypes"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `first-field`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
 }
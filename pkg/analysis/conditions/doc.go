@@ -37,5 +37,14 @@ If this is not a desired behaviour, set the linter config option `isFirstField`
 
 Protobuf tags and patch strategy are required for in-tree API types, but not for CRDs.
 When linting CRD based types, set the `useProtobuf` and `usePatchStrategy` config option to `Ignore` or `Forbid`.
+
+APIs with their own condition type can list it within `additionalConditionTypes`, either by name, or qualified by its import path.
+The type must have the `type`, `status`, `reason`, `message` and `lastTransitionTime` JSON fields.
+
+Slices of conditions with other names, found under the status of a root object, for example within list items,
+are checked for the required markers, but not for their tags or placement.
+
+Package-level constants declaring condition types, those with a type named `*ConditionType`, or untyped constants named `*Condition` or `*ConditionType`,
+should be typed and have PascalCase values, optionally prefixed by a DNS subdomain, e.g. `example.com/Ready`.
 */
 package conditions
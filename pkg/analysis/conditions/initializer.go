@@ -19,7 +19,9 @@ import (
 	"fmt"
 
 	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
 )
@@ -69,5 +71,20 @@ func validateConfig(cc *ConditionsConfig, fldPath *field.Path) field.ErrorList {
 		fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("usePatchStrategy"), cc.UsePatchStrategy, fmt.Sprintf("invalid value, must be one of %q, %q, %q, %q or omitted", ConditionsUsePatchStrategySuggestFix, ConditionsUsePatchStrategyWarn, ConditionsUsePatchStrategyIgnore, ConditionsUsePatchStrategyForbid)))
 	}
 
+	seen := sets.New[string]()
+
+	for i, conditionType := range cc.AdditionalConditionTypes {
+		switch {
+		case conditionType == "":
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("additionalConditionTypes").Index(i), conditionType, "condition type cannot be empty"))
+		case seen.Has(conditionType):
+			fieldErrors = append(fieldErrors, field.Duplicate(fldPath.Child("additionalConditionTypes").Index(i), conditionType))
+		}
+
+		seen.Insert(conditionType)
+	}
+
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(cc.Checks, name, fldPath.Child("checks"))...)
+
 	return fieldErrors
 }
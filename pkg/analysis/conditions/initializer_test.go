@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/conditions"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 )
@@ -128,6 +129,40 @@ var _ = Describe("conditions initializer", func() {
 				},
 				expectedErr: "conditions.usePatchStrategy: Invalid value: \"invalid\": invalid value, must be one of \"SuggestFix\", \"Warn\", \"Ignore\", \"Forbid\" or omitted",
 			}),
+			Entry("With a valid ConditionsConfig AdditionalConditionTypes", testCase{
+				config: conditions.ConditionsConfig{
+					AdditionalConditionTypes: []string{"Condition", "example.com/api/v1.Condition"},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid ConditionsConfig AdditionalConditionTypes: empty type", testCase{
+				config: conditions.ConditionsConfig{
+					AdditionalConditionTypes: []string{""},
+				},
+				expectedErr: "conditions.additionalConditionTypes[0]: Invalid value: \"\": condition type cannot be empty",
+			}),
+			Entry("With an invalid ConditionsConfig AdditionalConditionTypes: duplicate type", testCase{
+				config: conditions.ConditionsConfig{
+					AdditionalConditionTypes: []string{"Condition", "Condition"},
+				},
+				expectedErr: "conditions.additionalConditionTypes[1]: Duplicate value: \"Condition\"",
+			}),
+			Entry("With a valid ConditionsConfig: Checks: Disable", testCase{
+				config: conditions.ConditionsConfig{
+					Checks: checks.Config{
+						Disable: []string{"first-field"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid ConditionsConfig: Checks: unknown check", testCase{
+				config: conditions.ConditionsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "conditions.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: additional-markers,first-field,incorrect-tags,invalid-type,missing-markers,missing-tags,type-value,untyped-type",
+			}),
 		)
 	})
 })
@@ -0,0 +1,10 @@
+package conditions
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Condition embeds metav1.Condition, adding a severity.
+type Condition struct {
+	metav1.Condition `json:",inline"`
+
+	Severity string `json:"severity,omitempty"`
+}
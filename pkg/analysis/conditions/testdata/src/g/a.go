@@ -0,0 +1,139 @@
+package g
+
+import (
+	"example.com/conditions"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition is a custom condition type, with an additional severity.
+type Condition struct {
+	Type               string                 `json:"type"`
+	Status             metav1.ConditionStatus `json:"status"`
+	Reason             string                 `json:"reason"`
+	Message            string                 `json:"message"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime"`
+	Severity           string                 `json:"severity,omitempty"`
+}
+
+// IncompleteCondition is missing the reason and message fields.
+type IncompleteCondition struct {
+	Type               string                 `json:"type"`
+	Status             metav1.ConditionStatus `json:"status"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime"`
+}
+
+// UnlistedCondition is not configured as an additional condition type.
+type UnlistedCondition struct {
+	metav1.Condition `json:",inline"`
+}
+
+type CustomConditions struct {
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+type ImportedConditions struct {
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []conditions.Condition `json:"conditions,omitempty"`
+}
+
+type CustomConditionsMissingMarkers struct {
+	Conditions []Condition `json:"conditions,omitempty"` // want "Conditions field in CustomConditionsMissingMarkers is missing the following markers: listType=map, listMapKey=type, optional"
+}
+
+type IncompleteConditions struct {
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []IncompleteCondition `json:"conditions,omitempty"` // want "Conditions field in IncompleteConditions uses the condition type IncompleteCondition, which is missing the following fields: reason, message"
+}
+
+type UnlistedConditions struct {
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []UnlistedCondition `json:"conditions,omitempty"` // want "Conditions field in UnlistedConditions must be a slice of metav1.Condition, or of one of the additional condition types: Condition, example.com/conditions.Condition, IncompleteCondition"
+}
+
+// +kubebuilder:object:root=true
+type Widget struct {
+	Spec WidgetSpec `json:"spec"`
+
+	Status WidgetStatus `json:"status,omitempty"`
+}
+
+type WidgetSpec struct {
+	// specConditions are not under status, and so are not checked.
+	SpecConditions []metav1.Condition `json:"specConditions,omitempty"`
+}
+
+type WidgetStatus struct {
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Replicas []ReplicaStatus `json:"replicas,omitempty"`
+
+	// +optional
+	ReadinessConditions []Condition `json:"readinessConditions,omitempty"` // want "Conditions field in WidgetStatus is missing the following markers: listType=map, listMapKey=type"
+
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+}
+
+type ReplicaStatus struct {
+	Name string `json:"name"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	ReplicaConditions []metav1.Condition `json:"replicaConditions,omitempty"`
+
+	// +optional
+	HealthConditions []conditions.Condition `json:"healthConditions,omitempty"` // want "Conditions field in ReplicaStatus is missing the following markers: listType=map, listMapKey=type"
+}
+
+// WidgetConditionType is the type of a Widget condition.
+type WidgetConditionType string
+
+const (
+	WidgetReady       WidgetConditionType = "Ready"
+	WidgetProgressing WidgetConditionType = "progressing"     // want "condition type WidgetProgressing has the value \"progressing\", condition types should be PascalCase"
+	WidgetDegraded    WidgetConditionType = "Widget-Degraded" // want "condition type WidgetDegraded has the value \"Widget-Degraded\", condition types should be PascalCase"
+
+	// Condition types may be prefixed by a DNS subdomain.
+	WidgetHealthy      WidgetConditionType = "widgets.example.com/Healthy"
+	WidgetScaled       WidgetConditionType = "widgets.example.com/scaled" // want "condition type WidgetScaled has the value \"widgets.example.com/scaled\", condition types should be PascalCase"
+	WidgetReconciled   WidgetConditionType = "Widgets.Example/Reconciled" // want "condition type WidgetReconciled has the value \"Widgets.Example/Reconciled\", condition types should be PascalCase"
+	WidgetDoublePrefix WidgetConditionType = "example.com/widgets/Ready"  // want "condition type WidgetDoublePrefix has the value \"example.com/widgets/Ready\", condition types should be PascalCase"
+)
+
+// conditionTypeOf returns the condition type of the widget state.
+// Function-local constants are not condition type declarations, and so are not checked.
+func conditionTypeOf(ready bool) WidgetConditionType {
+	const notReadyCondition = "not-ready"
+
+	if !ready {
+		return notReadyCondition
+	}
+
+	return WidgetReady
+}
+
+const (
+	AvailableCondition = "Available" // want "condition type AvailableCondition should be declared as a typed constant, using a named string type such as ConditionType"
+
+	PausedConditionType string = "paused" // want "condition type PausedConditionType should be declared as a typed constant, using a named string type such as ConditionType" "condition type PausedConditionType has the value \"paused\", condition types should be PascalCase"
+
+	// Reasons are not condition types, and so are not checked.
+	AvailableReason = "available"
+)
@@ -22,15 +22,27 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "conflictingmarkers"
 
+func init() {
+	checks.DefaultRegistry().Register(Check(name))
+}
+
+// Check returns the check performed by the conflictingmarkers analyzer on behalf of the named linter.
+// Linters wrapping the conflictingmarkers analyzer should register this check with the checks registry.
+func Check(linter string) checks.Check {
+	return checks.New(linter, "conflict", config.SeverityWarning)
+}
+
 type analyzer struct {
 	conflictSets []ConflictSet
 }
@@ -131,7 +143,7 @@ func reportConflict(pass *analysis.Pass, field *ast.Field, conflictSet ConflictS
 		strings.Join(setDescriptions, ", "),
 		conflictSet.Description)
 
-	pass.Report(analysis.Diagnostic{
+	Check(pass.Analyzer.Name).Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		Message: message,
 	})
@@ -21,6 +21,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/conflictingmarkers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
@@ -31,6 +32,10 @@ const (
 	doc  = "Checks that fields marked as required do not have default values applied"
 )
 
+func init() {
+	checks.DefaultRegistry().Register(conflictingmarkers.Check(name))
+}
+
 var errUnexpectedInitializerType = errors.New("expected conflictingmarkers.Initializer() to be of type initializer.ConfigurableAnalyzerInitializer, but was not")
 
 // newAnalyzer creates a new analyzer that wraps conflictingmarkers with a predefined configuration
@@ -21,11 +21,13 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -33,7 +35,26 @@ const (
 	name = "defaults"
 )
 
+//nolint:gochecknoglobals
+var (
+	missingPreferredMarkerCheck = checks.New(name, "missing-preferred-marker", config.SeverityWarning)
+	secondaryMarkerCheck        = checks.New(name, "secondary-marker", config.SeverityWarning)
+	requiredWithDefaultCheck    = checks.New(name, "required-with-default", config.SeverityError)
+	notOptionalCheck            = checks.New(name, "not-optional", config.SeverityWarning)
+	missingOmitEmptyCheck       = checks.New(name, "missing-omitempty", config.SeverityWarning)
+	missingOmitZeroCheck        = checks.New(name, "missing-omitzero", config.SeverityWarning)
+)
+
 func init() {
+	checks.DefaultRegistry().Register(
+		missingPreferredMarkerCheck,
+		secondaryMarkerCheck,
+		requiredWithDefaultCheck,
+		notOptionalCheck,
+		missingOmitEmptyCheck,
+		missingOmitZeroCheck,
+	)
+
 	markershelper.DefaultRegistry().Register(
 		markers.DefaultMarker,
 		markers.KubebuilderDefaultMarker,
@@ -52,6 +73,7 @@ type analyzer struct {
 	secondaryDefaultMarker string
 	omitEmptyPolicy        OmitEmptyPolicy
 	omitZeroPolicy         OmitZeroPolicy
+	checks                 checks.Config
 }
 
 // newAnalyzer creates a new analyzer with the given configuration.
@@ -63,6 +85,7 @@ func newAnalyzer(cfg *DefaultsConfig) *analysis.Analyzer {
 	defaultConfig(cfg)
 
 	a := &analyzer{
+		checks:          cfg.Checks,
 		omitEmptyPolicy: cfg.OmitEmpty.Policy,
 		omitZeroPolicy:  cfg.OmitZero.Policy,
 	}
@@ -102,6 +125,8 @@ func defaultConfig(cfg *DefaultsConfig) {
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -169,7 +194,7 @@ func (a *analyzer) checkK8sDefault(pass *analysis.Pass, field *ast.Field, fieldM
 	k8sDefaultMarkers := fieldMarkers.Get(markers.K8sDefaultMarker)
 	for _, marker := range k8sDefaultMarkers {
 		payloadValue := marker.Payload.Value
-		pass.Report(analysis.Diagnostic{
+		missingPreferredMarkerCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has +%s but should also have +%s marker", qualifiedFieldName, markers.K8sDefaultMarker, a.preferredDefaultMarker),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -193,11 +218,11 @@ func (a *analyzer) checkSecondaryDefault(pass *analysis.Pass, field *ast.Field,
 
 	if hasBothDefaults {
 		// Both preferred and secondary markers exist - suggest removing secondary
-		pass.Report(reportShouldRemoveSecondaryMarker(field, secondaryDefaultMarkers, a.preferredDefaultMarker, a.secondaryDefaultMarker, qualifiedFieldName))
+		secondaryMarkerCheck.Report(pass, reportShouldRemoveSecondaryMarker(field, secondaryDefaultMarkers, a.preferredDefaultMarker, a.secondaryDefaultMarker, qualifiedFieldName))
 		return
 	}
 	// Only secondary marker exists - suggest replacing with preferred
-	pass.Report(reportShouldReplaceSecondaryMarker(field, secondaryDefaultMarkers, a.preferredDefaultMarker, a.secondaryDefaultMarker, qualifiedFieldName))
+	secondaryMarkerCheck.Report(pass, reportShouldReplaceSecondaryMarker(field, secondaryDefaultMarkers, a.preferredDefaultMarker, a.secondaryDefaultMarker, qualifiedFieldName))
 }
 
 func reportShouldReplaceSecondaryMarker(field *ast.Field, markers []markershelper.Marker, preferredMarker, secondaryMarker, qualifiedFieldName string) analysis.Diagnostic {
@@ -258,7 +283,7 @@ func reportShouldRemoveSecondaryMarker(field *ast.Field, markers []markershelper
 
 func (a *analyzer) checkDefaultNotRequired(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, qualifiedFieldName string) {
 	if utils.IsFieldRequired(field, markersAccess) {
-		pass.Report(analysis.Diagnostic{
+		requiredWithDefaultCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has a default value but is marked as required, which is contradictory", qualifiedFieldName),
 		})
@@ -272,7 +297,7 @@ func (a *analyzer) checkDefaultOptional(pass *analysis.Pass, field *ast.Field, m
 	}
 
 	if !utils.IsFieldOptional(field, markersAccess) {
-		pass.Report(analysis.Diagnostic{
+		notOptionalCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has a default value but is not marked as optional", qualifiedFieldName),
 		})
@@ -313,7 +338,7 @@ func (a *analyzer) reportMissingOmitEmpty(pass *analysis.Pass, field *ast.Field,
 
 	switch a.omitEmptyPolicy {
 	case OmitEmptyPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		missingOmitEmptyCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has a default value but does not have omitempty in its json tag", qualifiedFieldName),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -330,7 +355,7 @@ func (a *analyzer) reportMissingOmitEmpty(pass *analysis.Pass, field *ast.Field,
 			},
 		})
 	case OmitEmptyPolicyWarn:
-		pass.Reportf(field.Pos(), "field %s has a default value but does not have omitempty in its json tag", qualifiedFieldName)
+		missingOmitEmptyCheck.Reportf(pass, field.Pos(), "field %s has a default value but does not have omitempty in its json tag", qualifiedFieldName)
 	case OmitEmptyPolicyIgnore:
 		// Unreachable: this function is only called when the policy is not Ignore.
 		return
@@ -358,7 +383,7 @@ func (a *analyzer) reportMissingOmitZero(pass *analysis.Pass, field *ast.Field,
 
 	switch a.omitZeroPolicy {
 	case OmitZeroPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		missingOmitZeroCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has a default value but does not have omitzero in its json tag", qualifiedFieldName),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -375,7 +400,7 @@ func (a *analyzer) reportMissingOmitZero(pass *analysis.Pass, field *ast.Field,
 			},
 		})
 	case OmitZeroPolicyWarn:
-		pass.Reportf(field.Pos(), "field %s has a default value but does not have omitzero in its json tag", qualifiedFieldName)
+		missingOmitZeroCheck.Reportf(pass, field.Pos(), "field %s has a default value but does not have omitzero in its json tag", qualifiedFieldName)
 	case OmitZeroPolicyForbid:
 		// Unreachable: this function is only called when the policy is not Forbid.
 		return
@@ -15,6 +15,11 @@ limitations under the License.
 */
 package defaults
 
+import (
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
 // OmitEmptyPolicy is the policy for omitempty.
 // SuggestFix will suggest a fix for the field to add omitempty.
 // Warn will warn about the field to add omitempty.
@@ -52,7 +57,7 @@ const (
 // DefaultsConfig contains configuration for the defaults linter.
 type DefaultsConfig struct {
 	// PreferredDefaultMarker is the preferred marker to use for default values.
-	// If this field is not set, the default value is "default".
+	// If this field is not set, it is inherited from `preferences.defaultMarker` unless it is "Declarative", or the default value is "default".
 	// Valid values are "default" and "kubebuilder:default".
 	PreferredDefaultMarker string `json:"preferredDefaultMarker"`
 
@@ -65,6 +70,11 @@ type DefaultsConfig struct {
 	// This defines how the linter should handle fields with defaults, and whether they should have the omitzero tag or not.
 	// By default, struct fields with defaults will be expected to have the `omitzero` tag.
 	OmitZero DefaultsOmitZero `json:"omitzero"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `not-optional`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
 }
 
 // DefaultsOmitEmpty is the configuration for the `omitempty` tag on fields with defaults.
@@ -91,3 +101,16 @@ type DefaultsOmitZero struct {
 	// When otherwise not specified, the default value is "SuggestFix".
 	Policy OmitZeroPolicy `json:"policy"`
 }
+
+// supportedMarkerStyles are the marker styles that may be inherited from the shared preferences.
+// Declarative validation markers are not supported.
+//
+//nolint:gochecknoglobals
+var supportedMarkerStyles = []config.MarkerStyle{config.MarkerStyleStandard, config.MarkerStyleKubebuilder}
+
+// MarkerPreferences returns the settings that may be inherited from the shared preferences.
+func (c *DefaultsConfig) MarkerPreferences() []config.MarkerPreference {
+	return []config.MarkerPreference{
+		{Kind: config.MarkerKindDefault, Field: "preferredDefaultMarker", Value: &c.PreferredDefaultMarker, Styles: supportedMarkerStyles},
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
@@ -61,6 +62,8 @@ func validateConfig(cfg *DefaultsConfig, fldPath *field.Path) field.ErrorList {
 	fieldErrors = append(fieldErrors, validateOmitEmpty(cfg.OmitEmpty, fldPath.Child("omitempty"))...)
 	fieldErrors = append(fieldErrors, validateOmitZero(cfg.OmitZero, fldPath.Child("omitzero"))...)
 
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(cfg.Checks, name, fldPath.Child("checks"))...)
+
 	return fieldErrors
 }
 
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/defaults"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
@@ -126,6 +127,22 @@ var _ = Describe("defaults initializer", func() {
 				},
 				expectedErr: "defaults.omitzero.policy: Invalid value: \"invalid\": invalid value, must be one of \"Forbid\", \"Warn\", \"SuggestFix\" or omitted",
 			}),
+			Entry("With a valid DefaultsConfig: Checks: Disable", testCase{
+				config: defaults.DefaultsConfig{
+					Checks: checks.Config{
+						Disable: []string{"not-optional"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid DefaultsConfig: Checks: unknown check", testCase{
+				config: defaults.DefaultsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "defaults.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: missing-omitempty,missing-omitzero,missing-preferred-marker,not-optional,required-with-default,secondary-marker",
+			}),
 		)
 	})
 })
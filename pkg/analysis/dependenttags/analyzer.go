@@ -23,13 +23,22 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
+//nolint:gochecknoglobals
+var missingDependencyCheck = checks.New(name, "missing-dependency", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(missingDependencyCheck)
+}
+
 // analyzer implements the dependenttags linter.
 type analyzer struct {
 	cfg Config
@@ -98,7 +107,7 @@ func handleAll(pass *analysis.Pass, field *ast.Field, rule Rule, fieldMarkers ma
 	}
 
 	if len(missing) > 0 {
-		pass.Reportf(field.Pos(), "field %s with marker +%s is missing required marker(s): %s", qualifiedFieldName, rule.Identifier, strings.Join(missing, ", "))
+		missingDependencyCheck.Reportf(pass, field.Pos(), "field %s with marker +%s is missing required marker(s): %s", qualifiedFieldName, rule.Identifier, strings.Join(missing, ", "))
 	}
 }
 
@@ -118,6 +127,6 @@ func handleAny(pass *analysis.Pass, field *ast.Field, rule Rule, fieldMarkers ma
 			dependsOn[i] = fmt.Sprintf("+%s", d)
 		}
 
-		pass.Reportf(field.Pos(), "field %s with marker +%s requires at least one of the following markers, but none were found: %s", qualifiedFieldName, rule.Identifier, strings.Join(dependsOn, ", "))
+		missingDependencyCheck.Reportf(pass, field.Pos(), "field %s with marker +%s requires at least one of the following markers, but none were found: %s", qualifiedFieldName, rule.Identifier, strings.Join(dependsOn, ", "))
 	}
 }
@@ -0,0 +1,255 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package deprecatedfields
+
+import (
+	"go/ast"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "deprecatedfields"
+
+	// deprecatedPrefix is the prefix of the line that marks a field as deprecated within its godoc.
+	deprecatedPrefix = "Deprecated:"
+)
+
+//nolint:gochecknoglobals
+var (
+	notOptionalCheck        = checks.New(name, "not-optional", config.SeverityError)
+	defaultCheck            = checks.New(name, "default", config.SeverityWarning)
+	missingReplacementCheck = checks.New(name, "missing-replacement", config.SeverityWarning)
+	listMapKeyCheck         = checks.New(name, "list-map-key", config.SeverityError)
+	unionDiscriminatorCheck = checks.New(name, "union-discriminator", config.SeverityError)
+
+	// replacementPhrases are the phrases that indicate that the comment of a deprecated field
+	// describes what replaces it, or that there is no replacement.
+	replacementPhrases = []string{"use ", "instead", "replaced by", "superseded by", "in favor of", "in favour of", "no replacement"}
+)
+
+func init() {
+	checks.DefaultRegistry().Register(notOptionalCheck, defaultCheck, missingReplacementCheck, listMapKeyCheck, unionDiscriminatorCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.K8sDeprecatedMarker,
+		markers.UnionDiscriminatorMarker,
+		markers.K8sUnionDiscriminatorMarker,
+		markers.KubebuilderListMapKeyMarker,
+		markers.K8sListMapKeyMarker,
+	)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *DeprecatedFieldsConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &DeprecatedFieldsConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that deprecated fields are optional, have no default, describe their replacement, and are not used as list map keys or union discriminators.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer, extractjsontags.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	jsonTags, ok := pass.ResultOf[extractjsontags.Analyzer].(extractjsontags.StructFieldTags)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetJSONTags
+	}
+
+	inspect.InspectFieldsIncludingListTypes(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		checkListMapKeys(pass, field, markersAccess, jsonTags, qualifiedFieldName)
+
+		if !isDeprecated(field, markersAccess.FieldMarkers(field)) {
+			return
+		}
+
+		checkDeprecatedField(pass, field, markersAccess, qualifiedFieldName)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkDeprecatedField checks that the deprecated field is optional, has no default, describes its replacement,
+// and is not the discriminator of a union.
+func checkDeprecatedField(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, qualifiedFieldName string) {
+	fieldMarkers := markersAccess.FieldMarkers(field)
+
+	switch {
+	case utils.IsFieldRequired(field, markersAccess):
+		notOptionalCheck.Reportf(pass, field.Pos(), "deprecated field %s is marked as required. Deprecated fields must be optional, so that clients can stop setting them", qualifiedFieldName)
+	case !utils.IsFieldOptional(field, markersAccess):
+		notOptionalCheck.Reportf(pass, field.Pos(), "deprecated field %s must be marked as optional, so that clients can stop setting it", qualifiedFieldName)
+	}
+
+	for _, marker := range []string{markers.DefaultMarker, markers.KubebuilderDefaultMarker, markers.K8sDefaultMarker} {
+		if fieldMarkers.Has(marker) {
+			defaultCheck.Reportf(pass, field.Pos(), "deprecated field %s should not have a default, marked with %s. A default would continue to set the deprecated field for clients that no longer use it", qualifiedFieldName, marker)
+		}
+	}
+
+	if !describesReplacement(field.Doc) {
+		missingReplacementCheck.Reportf(pass, field.Pos(), "deprecated field %s should describe what replaces it in its comment, e.g. \"Deprecated: Use otherField instead.\"", qualifiedFieldName)
+	}
+
+	for _, marker := range []string{markers.UnionDiscriminatorMarker, markers.K8sUnionDiscriminatorMarker} {
+		if fieldMarkers.Has(marker) {
+			unionDiscriminatorCheck.Reportf(pass, field.Pos(), "deprecated field %s should not be used as a union discriminator, marked with %s", qualifiedFieldName, marker)
+		}
+	}
+}
+
+// checkListMapKeys checks that the list map keys of the field do not refer to deprecated fields of the list items.
+func checkListMapKeys(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, jsonTags extractjsontags.StructFieldTags, qualifiedFieldName string) {
+	fieldMarkers := markersAccess.FieldMarkers(field)
+
+	listMapKeyMarkers := slices.Concat(fieldMarkers.Get(markers.KubebuilderListMapKeyMarker), fieldMarkers.Get(markers.K8sListMapKeyMarker))
+	if len(listMapKeyMarkers) == 0 {
+		return
+	}
+
+	itemFields := listItemFields(pass, jsonTags, field.Type)
+	if itemFields == nil {
+		return
+	}
+
+	for _, marker := range listMapKeyMarkers {
+		keyName := marker.Payload.Value
+
+		for _, itemField := range itemFields.List {
+			if jsonTags.FieldTags(itemField).Name != keyName || !isDeprecated(itemField, markersAccess.FieldMarkers(itemField)) {
+				continue
+			}
+
+			listMapKeyCheck.Reportf(pass, field.Pos(), "%s uses deprecated field %s as a listMapKey. Deprecated fields should not be used as list map keys, as they cannot be removed while in use", qualifiedFieldName, utils.GetQualifiedFieldName(pass, itemField))
+		}
+	}
+}
+
+// listItemFields returns the fields of the struct that is the item type of the list,
+// with the fields of embedded structs promoted to the top level.
+func listItemFields(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, expr ast.Expr) *ast.FieldList {
+	switch typ := expr.(type) {
+	case *ast.ArrayType:
+		return structFields(pass, jsonTags, typ.Elt)
+	case *ast.Ident:
+		typeSpec, ok := utils.LookupTypeSpec(pass, typ)
+		if !ok {
+			return nil
+		}
+
+		return listItemFields(pass, jsonTags, typeSpec.Type)
+	}
+
+	return nil
+}
+
+// structFields returns the fields of the struct type, with the fields of embedded structs promoted to the top level.
+func structFields(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, expr ast.Expr) *ast.FieldList {
+	switch typ := expr.(type) {
+	case *ast.StarExpr:
+		return structFields(pass, jsonTags, typ.X)
+	case *ast.Ident:
+		typeSpec, ok := utils.LookupTypeSpec(pass, typ)
+		if !ok {
+			return nil
+		}
+
+		sTyp, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return nil
+		}
+
+		return utils.FlattenStructFields(pass, jsonTags, sTyp.Fields)
+	}
+
+	return nil
+}
+
+// isDeprecated determines whether the field is deprecated, either by a line of its godoc
+// starting with `Deprecated:`, or by a deprecated marker.
+func isDeprecated(field *ast.Field, fieldMarkers markershelper.MarkerSet) bool {
+	return fieldMarkers.Has(markers.K8sDeprecatedMarker) || deprecationNotice(field.Doc) != ""
+}
+
+// deprecationNotice returns the comment from the first line that starts with `Deprecated:` onward, if any.
+// The notice may start its own paragraph, or directly follow the description of the field.
+func deprecationNotice(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+
+	lines := strings.Split(doc.Text(), "\n")
+
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), deprecatedPrefix) {
+			return strings.TrimSpace(strings.Join(lines[i:], "\n"))
+		}
+	}
+
+	return ""
+}
+
+// describesReplacement determines whether the comment describes what replaces the deprecated field.
+// Where the comment has a deprecation notice, only the notice is considered.
+func describesReplacement(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+
+	text := deprecationNotice(doc)
+	if text == "" {
+		text = doc.Text()
+	}
+
+	text = strings.ToLower(text)
+
+	for _, phrase := range replacementPhrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `deprecatedfields` linter checks that deprecated fields follow a consistent lifecycle.
+
+A field is deprecated when a line of its comment starts with `Deprecated:`, following the Go convention,
+or when it is marked with `+k8s:deprecated`.
+The `Deprecated:` line may start its own paragraph, or directly follow the description of the field.
+
+Deprecated fields must remain readable and writable until they are removed, but clients should be able to stop using them.
+The linter therefore checks that deprecated fields:
+  - Are optional, and are not marked as required.
+  - Do not have a default value, which would continue to set the field for clients that no longer use it.
+  - Describe what replaces them in their comment, e.g. `Deprecated: Use otherField instead.`, or that there is no replacement.
+  - Are not the discriminator of a union.
+  - Are not used as a `listMapKey` of a list of objects.
+*/
+package deprecatedfields
@@ -0,0 +1,109 @@
+package a
+
+type DeprecatedFieldsTestStruct struct {
+	// name is not deprecated.
+	// +required
+	Name string `json:"name"`
+
+	// oldName is the previous name of the object.
+	//
+	// Deprecated: Use name instead.
+	// +optional
+	OldName string `json:"oldName,omitempty"`
+
+	// legacyMode is no longer used.
+	// Deprecated: This field has no replacement, and is ignored.
+	// +optional
+	LegacyMode string `json:"legacyMode,omitempty"`
+
+	// inlineTimeout is the previous timeout.
+	// Deprecated: Use timeout instead.
+	InlineTimeout int32 `json:"inlineTimeout,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.InlineTimeout must be marked as optional, so that clients can stop setting it"
+
+	// inlineMode is the previous mode.
+	// Deprecated: Use mode instead.
+	// +optional
+	// +kubebuilder:default=Auto
+	InlineMode string `json:"inlineMode,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.InlineMode should not have a default, marked with kubebuilder:default"
+
+	// inlineReplicas is used instead of the replicas of the template.
+	// Deprecated: This field will be removed in a future release.
+	// +optional
+	InlineReplicas int32 `json:"inlineReplicas,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.InlineReplicas should describe what replaces it in its comment"
+
+	// retired is superseded by the policy field.
+	// +k8s:deprecated
+	// +k8s:optional
+	Retired string `json:"retired,omitempty"`
+
+	// oldPolicy is the previous policy.
+	//
+	// Deprecated: Use policy instead.
+	// +required
+	OldPolicy string `json:"oldPolicy"` // want "deprecated field DeprecatedFieldsTestStruct.OldPolicy is marked as required. Deprecated fields must be optional, so that clients can stop setting them"
+
+	// oldTimeout is the previous timeout.
+	//
+	// Deprecated: Use timeout instead.
+	OldTimeout int32 `json:"oldTimeout,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.OldTimeout must be marked as optional, so that clients can stop setting it"
+
+	// oldMode is the previous mode.
+	//
+	// Deprecated: Use mode instead.
+	// +optional
+	// +kubebuilder:default=Auto
+	OldMode string `json:"oldMode,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.OldMode should not have a default, marked with kubebuilder:default. A default would continue to set the deprecated field for clients that no longer use it"
+
+	// oldReplicas is the previous number of replicas.
+	//
+	// Deprecated: This field will be removed in a future release.
+	// +optional
+	OldReplicas int32 `json:"oldReplicas,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.OldReplicas should describe what replaces it in its comment, e.g. \"Deprecated: Use otherField instead.\""
+
+	// +k8s:deprecated
+	// +optional
+	Undocumented string `json:"undocumented,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.Undocumented should describe what replaces it in its comment"
+
+	// type is the discriminator of the union.
+	//
+	// Deprecated: Use kind instead.
+	// +unionDiscriminator
+	// +optional
+	Type string `json:"type,omitempty"` // want "deprecated field DeprecatedFieldsTestStruct.Type should not be used as a union discriminator, marked with unionDiscriminator"
+
+	// items is a list keyed by a deprecated field.
+	// +listType=map
+	// +listMapKey=oldKey
+	// +optional
+	Items []Item `json:"items,omitempty"` // want "DeprecatedFieldsTestStruct.Items uses deprecated field Item.OldKey as a listMapKey. Deprecated fields should not be used as list map keys, as they cannot be removed while in use"
+
+	// keyedItems is a list keyed by a field that is not deprecated.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	KeyedItems []Item `json:"keyedItems,omitempty"`
+
+	// aliasedItems is a list keyed by a deprecated field of an embedded struct.
+	// +listType=map
+	// +k8s:listMapKey=oldKey
+	// +optional
+	AliasedItems Items `json:"aliasedItems,omitempty"` // want "DeprecatedFieldsTestStruct.AliasedItems uses deprecated field Item.OldKey as a listMapKey"
+}
+
+type Items []*WrappedItem
+
+type WrappedItem struct {
+	Item `json:",inline"`
+}
+
+type Item struct {
+	// key is the key of the item.
+	// +required
+	Key string `json:"key"`
+
+	// oldKey is the previous key of the item.
+	//
+	// Deprecated: Use key instead.
+	// +optional
+	OldKey string `json:"oldKey,omitempty"`
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+driver coordinates the diagnostics reported by the individual linters, so that the suggested fixes
+they provide can be applied together.
+
+Several linters may suggest edits to the same json tag or marker line.
+golangci-lint groups all of the edits from the Kube API Linter together, and where any two edits overlap,
+it refuses to apply any of the edits within the file.
+
+To prevent this, New wraps each linter so that its diagnostics are collected rather than reported directly.
+A resolver then compares the edits suggested by each linter, per package, before the diagnostics are reported:
+  - Edits that are identical to an edit suggested by another linter are dropped, so that they are only applied once.
+  - Edits that fall entirely within a deletion suggested by another linter are dropped, as the deletion subsumes them.
+  - Edits that otherwise overlap an edit suggested by another linter are conflicting. The diagnostics are replaced
+    by a single diagnostic, without any suggested fixes, that names each of the linters involved.
+
+Edits that do not overlap, for example, adding `omitempty` to the json tag and adding a pointer to the field type,
+are compatible and are reported unchanged.
+Conflicts between edits suggested by the same linter are left for the linter itself to resolve.
+
+The analyzers returned by New report the resolved diagnostics under the original linter names,
+so that the resulting issues are identical to those reported by the linters directly.
+*/
+package driver
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package driver
+
+import (
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+)
+
+const resolverName = "kalresolver"
+
+// collected is the result of a collector analyzer.
+// It holds the diagnostics reported by the wrapped linter.
+type collected struct {
+	diagnostics []analysis.Diagnostic
+}
+
+// resolution is the result of the resolver analyzer.
+// It holds the resolved diagnostics for each linter, keyed by the linter name.
+type resolution struct {
+	diagnostics map[string][]analysis.Diagnostic
+}
+
+// New wraps the analyzers so that the suggested fixes from each are resolved against each other
+// before they are reported.
+// The returned analyzers report the resolved diagnostics under the names of the original analyzers.
+// The original analyzers are not modified.
+func New(analyzers []*analysis.Analyzer) []*analysis.Analyzer {
+	collectors := make([]*analysis.Analyzer, 0, len(analyzers))
+
+	for _, a := range analyzers {
+		collectors = append(collectors, newCollector(a))
+	}
+
+	resolver := newResolver(collectors)
+
+	out := make([]*analysis.Analyzer, 0, len(analyzers))
+
+	for _, a := range analyzers {
+		out = append(out, newReporter(a, resolver))
+	}
+
+	return out
+}
+
+// newCollector returns a copy of the analyzer that collects the diagnostics it reports
+// and returns them as its result.
+func newCollector(a *analysis.Analyzer) *analysis.Analyzer {
+	c := *a
+	c.ResultType = reflect.TypeOf(&collected{})
+
+	c.Run = func(pass *analysis.Pass) (any, error) {
+		out := &collected{}
+
+		p := *pass
+		p.Report = func(diag analysis.Diagnostic) {
+			out.diagnostics = append(out.diagnostics, diag)
+		}
+
+		if _, err := a.Run(&p); err != nil {
+			return nil, err
+		}
+
+		return out, nil
+	}
+
+	return &c
+}
+
+// newResolver returns an analyzer that resolves the suggested fixes from each of the collectors.
+func newResolver(collectors []*analysis.Analyzer) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name:       resolverName,
+		Doc:        "Resolves overlapping suggested fixes between the Kube API Linter linters",
+		Requires:   collectors,
+		ResultType: reflect.TypeOf(&resolution{}),
+		Run: func(pass *analysis.Pass) (any, error) {
+			entries := []*entry{}
+
+			for _, c := range collectors {
+				result, ok := pass.ResultOf[c].(*collected)
+				if !ok {
+					continue
+				}
+
+				for _, diag := range result.diagnostics {
+					entries = append(entries, &entry{linter: c.Name, diag: diag})
+				}
+			}
+
+			return &resolution{diagnostics: resolve(entries)}, nil
+		},
+	}
+}
+
+// newReporter returns an analyzer, named after the original analyzer, that reports
+// the resolved diagnostics for the original analyzer.
+func newReporter(a *analysis.Analyzer, resolver *analysis.Analyzer) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name:     a.Name,
+		Doc:      a.Doc,
+		URL:      a.URL,
+		Requires: []*analysis.Analyzer{resolver},
+		Run: func(pass *analysis.Pass) (any, error) {
+			result, ok := pass.ResultOf[resolver].(*resolution)
+			if !ok {
+				return nil, kalerrors.ErrCouldNotGetResolvedDiagnostics
+			}
+
+			for _, diag := range result.diagnostics[a.Name] {
+				pass.Report(diag)
+			}
+
+			return nil, nil //nolint:nilnil
+		},
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package driver_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/driver"
+)
+
+// newTestAnalyzer creates an analyzer that suggests replacing the type of each field named Conflict
+// with the given type, and suggests making each field named Pointer a pointer.
+func newTestAnalyzer(name, conflictType string) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name: name,
+		Doc:  "suggests fixes for struct fields",
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				ast.Inspect(file, func(n ast.Node) bool {
+					field, ok := n.(*ast.Field)
+					if !ok || len(field.Names) == 0 {
+						return true
+					}
+
+					switch field.Names[0].Name {
+					case "Pointer":
+						pass.Report(analysis.Diagnostic{
+							Pos:     field.Pos(),
+							Message: name + " suggests a pointer",
+							SuggestedFixes: []analysis.SuggestedFix{{
+								Message:   "add pointer",
+								TextEdits: []analysis.TextEdit{{Pos: field.Type.Pos(), NewText: []byte("*")}},
+							}},
+						})
+					case "Conflict":
+						pass.Report(analysis.Diagnostic{
+							Pos:     field.Pos(),
+							Message: name + " suggests " + conflictType,
+							SuggestedFixes: []analysis.SuggestedFix{{
+								Message:   "replace type",
+								TextEdits: []analysis.TextEdit{{Pos: field.Type.Pos(), End: field.Type.End(), NewText: []byte(conflictType)}},
+							}},
+						})
+					}
+
+					return true
+				})
+			}
+
+			return nil, nil //nolint:nilnil
+		},
+	}
+}
+
+func TestDriver(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzers := driver.New([]*analysis.Analyzer{
+		newTestAnalyzer("first", "int"),
+		newTestAnalyzer("second", "bool"),
+	})
+
+	// The first linter reports the conflict, and provides the pointer fix.
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzers[0], "a")
+
+	// The second linter reports only the pointer diagnostic, without a fix.
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzers[1], "b")
+}
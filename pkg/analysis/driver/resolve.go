@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package driver
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// entry is a diagnostic reported by a linter, along with the state of its resolution.
+type entry struct {
+	linter string
+	diag   analysis.Diagnostic
+
+	// conflicts are the entries whose suggested fixes conflict with this entry.
+	// They are reported as part of this entry.
+	conflicts []*entry
+
+	// dropped indicates that the entry is reported as part of another entry.
+	dropped bool
+}
+
+// resolve compares the suggested fixes of each entry against those of entries from other linters,
+// and returns the resolved diagnostics for each linter.
+// Entries are compared in order, so where edits are duplicated, the edit from the first entry is kept.
+func resolve(entries []*entry) map[string][]analysis.Diagnostic {
+	for i, e := range entries {
+		for _, prev := range entries[:i] {
+			if prev.dropped || prev.linter == e.linter {
+				continue
+			}
+
+			if !resolvePair(prev, e) {
+				prev.conflicts = append(prev.conflicts, e)
+				e.dropped = true
+
+				break
+			}
+		}
+	}
+
+	out := map[string][]analysis.Diagnostic{}
+
+	for _, e := range entries {
+		if e.dropped {
+			continue
+		}
+
+		out[e.linter] = append(out[e.linter], e.diagnostic())
+	}
+
+	return out
+}
+
+// resolvePair removes any edits from either entry that are duplicated or subsumed by the other.
+// It returns false when the entries have edits that conflict.
+func resolvePair(prev, e *entry) bool {
+	// Check for conflicts before modifying either entry so that a conflict
+	// reports the entries as they were suggested.
+	for _, x := range edits(prev.diag) {
+		for _, y := range edits(e.diag) {
+			if overlaps(x, y) && !identical(x, y) && !subsumes(x, y) && !subsumes(y, x) {
+				return false
+			}
+		}
+	}
+
+	for _, x := range edits(prev.diag) {
+		e.diag.SuggestedFixes = removeEdits(e.diag.SuggestedFixes, func(y analysis.TextEdit) bool {
+			return identical(x, y) || subsumes(x, y)
+		})
+	}
+
+	for _, y := range edits(e.diag) {
+		prev.diag.SuggestedFixes = removeEdits(prev.diag.SuggestedFixes, func(x analysis.TextEdit) bool {
+			return subsumes(y, x)
+		})
+	}
+
+	return true
+}
+
+// diagnostic returns the diagnostic to report for the entry.
+// Where the entry has conflicts, a single diagnostic is returned, naming each of the linters
+// and without any suggested fixes.
+func (e *entry) diagnostic() analysis.Diagnostic {
+	if len(e.conflicts) == 0 {
+		return e.diag
+	}
+
+	linters := []string{e.linter}
+	messages := []string{e.diag.Message}
+
+	for _, c := range e.conflicts {
+		linters = append(linters, c.linter)
+		messages = append(messages, c.diag.Message)
+	}
+
+	diag := e.diag
+	diag.SuggestedFixes = nil
+	diag.Message = fmt.Sprintf("conflicting fixes suggested by %s: %s", joinNames(linters), strings.Join(messages, "; "))
+
+	return diag
+}
+
+// edits returns all of the text edits within the suggested fixes of the diagnostic.
+func edits(diag analysis.Diagnostic) []analysis.TextEdit {
+	out := []analysis.TextEdit{}
+
+	for _, fix := range diag.SuggestedFixes {
+		out = append(out, fix.TextEdits...)
+	}
+
+	return out
+}
+
+// removeEdits removes the edits matching the predicate from the fixes.
+// Fixes that no longer have any edits are removed.
+func removeEdits(fixes []analysis.SuggestedFix, remove func(analysis.TextEdit) bool) []analysis.SuggestedFix {
+	var out []analysis.SuggestedFix
+
+	for _, fix := range fixes {
+		var textEdits []analysis.TextEdit
+
+		for _, edit := range fix.TextEdits {
+			if !remove(edit) {
+				textEdits = append(textEdits, edit)
+			}
+		}
+
+		if len(textEdits) == 0 {
+			continue
+		}
+
+		fix.TextEdits = textEdits
+		out = append(out, fix)
+	}
+
+	return out
+}
+
+// end returns the end of the edit, treating an edit without an end as an insertion.
+func end(edit analysis.TextEdit) token.Pos {
+	if !edit.End.IsValid() {
+		return edit.Pos
+	}
+
+	return edit.End
+}
+
+// overlaps returns whether the edits overlap.
+// Insertions at the same position, or at the boundary of another edit, do not overlap.
+func overlaps(x, y analysis.TextEdit) bool {
+	return x.Pos < end(y) && y.Pos < end(x)
+}
+
+// identical returns whether the edits make the same change.
+func identical(x, y analysis.TextEdit) bool {
+	return x.Pos == y.Pos && end(x) == end(y) && bytes.Equal(x.NewText, y.NewText)
+}
+
+// subsumes returns whether x is a deletion that removes all of the text changed by y,
+// such that y no longer needs to be applied.
+// Insertions at the boundary of the deletion are not subsumed, as the inserted text is retained.
+func subsumes(x, y analysis.TextEdit) bool {
+	if len(x.NewText) != 0 || x.Pos == end(x) {
+		return false
+	}
+
+	if y.Pos == end(y) {
+		return x.Pos < y.Pos && y.Pos < end(x)
+	}
+
+	return x.Pos <= y.Pos && end(y) <= end(x)
+}
+
+// joinNames joins the names into a human readable list.
+func joinNames(names []string) string {
+	if len(names) <= 1 {
+		return strings.Join(names, "")
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
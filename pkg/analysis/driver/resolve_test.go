@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package driver
+
+import (
+	"go/token"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/tools/go/analysis"
+)
+
+func diagnostic(message string, edits ...analysis.TextEdit) analysis.Diagnostic {
+	diag := analysis.Diagnostic{Pos: 1, Message: message}
+
+	if len(edits) > 0 {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{Message: "fix", TextEdits: edits}}
+	}
+
+	return diag
+}
+
+func insert(pos token.Pos, text string) analysis.TextEdit {
+	return analysis.TextEdit{Pos: pos, NewText: []byte(text)}
+}
+
+func replace(pos, end token.Pos, text string) analysis.TextEdit {
+	return analysis.TextEdit{Pos: pos, End: end, NewText: []byte(text)}
+}
+
+func TestResolve(t *testing.T) {
+	type testcase struct {
+		name     string
+		entries  []*entry
+		expected map[string][]analysis.Diagnostic
+	}
+
+	testcases := []testcase{
+		{
+			name: "diagnostics without fixes are unchanged",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("a")},
+				{linter: "b", diag: diagnostic("b")},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("a")},
+				"b": {diagnostic("b")},
+			},
+		},
+		{
+			name: "non-overlapping edits are compatible",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("add pointer", insert(10, "*"))},
+				{linter: "b", diag: diagnostic("add omitempty", insert(20, ",omitempty"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("add pointer", insert(10, "*"))},
+				"b": {diagnostic("add omitempty", insert(20, ",omitempty"))},
+			},
+		},
+		{
+			name: "insertions at the same position are compatible",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("add optional", insert(10, "// +optional\n"))},
+				{linter: "b", diag: diagnostic("add default", insert(10, "// +default=1\n"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("add optional", insert(10, "// +optional\n"))},
+				"b": {diagnostic("add default", insert(10, "// +default=1\n"))},
+			},
+		},
+		{
+			name: "identical edits are only suggested once",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("add omitempty", insert(20, ",omitempty"))},
+				{linter: "b", diag: diagnostic("also add omitempty", insert(20, ",omitempty"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("add omitempty", insert(20, ",omitempty"))},
+				"b": {diagnostic("also add omitempty")},
+			},
+		},
+		{
+			name: "edits within a deletion are subsumed",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("replace marker", replace(12, 20, "+default"))},
+				{linter: "b", diag: diagnostic("remove duplicate marker", replace(10, 30, ""))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("replace marker")},
+				"b": {diagnostic("remove duplicate marker", replace(10, 30, ""))},
+			},
+		},
+		{
+			name: "insertions at the boundary of a deletion are not subsumed",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("remove marker", replace(10, 30, ""))},
+				{linter: "b", diag: diagnostic("add marker", insert(30, "// +optional\n"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("remove marker", replace(10, 30, ""))},
+				"b": {diagnostic("add marker", insert(30, "// +optional\n"))},
+			},
+		},
+		{
+			name: "overlapping edits conflict",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("replace with foo", replace(10, 20, "foo"))},
+				{linter: "b", diag: diagnostic("replace with bar", replace(15, 25, "bar"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("conflicting fixes suggested by a and b: replace with foo; replace with bar")},
+			},
+		},
+		{
+			name: "conflicts with several linters are reported together",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("replace with foo", replace(10, 20, "foo"))},
+				{linter: "b", diag: diagnostic("replace with bar", replace(10, 20, "bar"))},
+				{linter: "c", diag: diagnostic("replace with baz", replace(10, 20, "baz"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {diagnostic("conflicting fixes suggested by a, b and c: replace with foo; replace with bar; replace with baz")},
+			},
+		},
+		{
+			name: "overlapping edits from the same linter are unchanged",
+			entries: []*entry{
+				{linter: "a", diag: diagnostic("replace with foo", replace(10, 20, "foo"))},
+				{linter: "a", diag: diagnostic("replace with bar", replace(10, 20, "bar"))},
+			},
+			expected: map[string][]analysis.Diagnostic{
+				"a": {
+					diagnostic("replace with foo", replace(10, 20, "foo")),
+					diagnostic("replace with bar", replace(10, 20, "bar")),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(resolve(tc.entries)).To(Equal(tc.expected))
+		})
+	}
+}
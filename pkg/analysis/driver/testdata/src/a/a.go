@@ -0,0 +1,7 @@
+package a
+
+type A struct {
+	Pointer string // want "first suggests a pointer"
+
+	Conflict string // want "conflicting fixes suggested by first and second: first suggests int; second suggests bool"
+}
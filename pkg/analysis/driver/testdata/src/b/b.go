@@ -0,0 +1,7 @@
+package b
+
+type B struct {
+	Pointer string // want "second suggests a pointer"
+
+	Conflict string
+}
@@ -22,17 +22,26 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const (
 	name = "duplicatemarkers"
 )
 
+//nolint:gochecknoglobals
+var duplicateMarkerCheck = checks.New(name, "duplicate-marker", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(duplicateMarkerCheck)
+}
+
 // Analyzer is the analyzer for the duplicatemarkers package.
 // It checks for duplicate markers on struct fields.
 var Analyzer = &analysis.Analyzer{
@@ -98,7 +107,7 @@ func checkTypeSpec(pass *analysis.Pass, typeSpec *ast.TypeSpec, markersAccess ma
 }
 
 func report(pass *analysis.Pass, pos token.Pos, fieldName string, marker markers.Marker) {
-	pass.Report(analysis.Diagnostic{
+	duplicateMarkerCheck.Report(pass, analysis.Diagnostic{
 		Pos:     pos,
 		Message: fmt.Sprintf("%s has duplicated markers %s", fieldName, marker),
 		SuggestedFixes: []analysis.SuggestedFix{
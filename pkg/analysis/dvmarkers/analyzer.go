@@ -0,0 +1,336 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvmarkers
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "dvmarkers"
+
+	// listTypeMap is the value of the listType marker for lists that are merged by key.
+	listTypeMap = "map"
+)
+
+//nolint:gochecknoglobals
+var (
+	listMapKeyCheck   = checks.New(name, "list-map-key", config.SeverityError)
+	itemSelectorCheck = checks.New(name, "item-selector", config.SeverityError)
+	eachCheck         = checks.New(name, "each", config.SeverityError)
+	subfieldCheck     = checks.New(name, "subfield", config.SeverityError)
+	formatCheck       = checks.New(name, "format", config.SeverityError)
+)
+
+// supportedFormats are the formats supported by the `+k8s:format` marker.
+//
+//nolint:gochecknoglobals
+var supportedFormats = []string{
+	"k8s-extended-resource-name",
+	"k8s-ip",
+	"k8s-label-key",
+	"k8s-label-value",
+	"k8s-long-name",
+	"k8s-long-name-caseless",
+	"k8s-resource-fully-qualified-name",
+	"k8s-resource-pool-name",
+	"k8s-short-name",
+	"k8s-uuid",
+}
+
+func init() {
+	checks.DefaultRegistry().Register(listMapKeyCheck, itemSelectorCheck, eachCheck, subfieldCheck, formatCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderListMapKeyMarker,
+		markers.K8sListTypeMarker,
+		markers.K8sListMapKeyMarker,
+		markers.K8sEachValMarker,
+		markers.K8sEachKeyMarker,
+		markers.K8sItemMarker,
+		markers.K8sSubfieldMarker,
+		markers.K8sFormatMarker,
+	)
+}
+
+type analyzer struct {
+	formats sets.Set[string]
+	checks  checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *DVMarkersConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &DVMarkersConfig{}
+	}
+
+	a := &analyzer{
+		formats: sets.New(supportedFormats...).Insert(cfg.AdditionalFormats...),
+		checks:  cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that declarative validation markers are used with types and values that they support.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+// target is the type that a marker applies to.
+type target struct {
+	// description describes the field or type that the marker is declared on, for use within messages.
+	description string
+
+	// pos is the position that issues with the marker are reported at.
+	pos token.Pos
+
+	// typ is the type that the marker applies to.
+	// For markers nested within `+k8s:eachVal`, `+k8s:item` or `+k8s:subfield`, this is the type of the values selected.
+	typ types.Type
+
+	// listMapKeys are the list map keys declared for the type.
+	// These are only known for markers declared directly on a field or type.
+	listMapKeys []string
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		a.checkMarkers(pass, fmt.Sprintf("field %s", qualifiedFieldName), field.Pos(), pass.TypesInfo.TypeOf(field.Type), markersAccess.FieldMarkers(field), utils.TypeAwareMarkerCollectionForField(pass, markersAccess, field))
+	})
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		typeMarkers := markersAccess.TypeMarkers(typeSpec)
+		a.checkMarkers(pass, fmt.Sprintf("type %s", typeSpec.Name.Name), typeSpec.Pos(), pass.TypesInfo.TypeOf(typeSpec.Type), typeMarkers, typeMarkers)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkMarkers checks the declarative validation markers declared directly on a field or type.
+// The list map keys are read from the list markers, which for a field also include the markers of its named type,
+// as the keys of a list may be declared on the named list type.
+func (a *analyzer) checkMarkers(pass *analysis.Pass, description string, pos token.Pos, typ types.Type, markerSet, listMarkers markershelper.MarkerSet) {
+	if typ == nil {
+		return
+	}
+
+	listMapKeys := []string{}
+	for _, marker := range slices.Concat(listMarkers.Get(markers.K8sListMapKeyMarker), listMarkers.Get(markers.KubebuilderListMapKeyMarker)) {
+		listMapKeys = append(listMapKeys, marker.Payload.Value)
+	}
+
+	for _, marker := range markerSet.Get(markers.K8sListTypeMarker) {
+		if marker.Payload.Value == listTypeMap && !listMarkers.Has(markers.K8sListMapKeyMarker) {
+			listMapKeyCheck.Reportf(pass, pos, "%s has the marker %q, but no %q marker naming the keys of the list", description, markers.K8sListTypeMarker+"="+listTypeMap, markers.K8sListMapKeyMarker)
+		}
+	}
+
+	dvMarkers := []markershelper.Marker{}
+
+	for _, marker := range markerSet.UnsortedList() {
+		if marker.Type == markershelper.MarkerTypeDeclarativeValidation {
+			dvMarkers = append(dvMarkers, marker)
+		}
+	}
+
+	slices.SortFunc(dvMarkers, func(a, b markershelper.Marker) int {
+		return cmp.Or(cmp.Compare(a.Pos, b.Pos), cmp.Compare(a.Identifier, b.Identifier))
+	})
+
+	for _, marker := range dvMarkers {
+		a.checkMarker(pass, target{description: description, pos: pos, typ: typ, listMapKeys: listMapKeys}, &marker)
+	}
+}
+
+// checkMarker checks that the marker is valid for the target type,
+// and then checks any marker nested within its payload against the type that the marker selects.
+func (a *analyzer) checkMarker(pass *analysis.Pass, t target, marker *markershelper.Marker) {
+	next := t
+
+	switch marker.Identifier {
+	case markers.K8sEachValMarker:
+		elem, ok := elemType(t.typ)
+		if !ok {
+			eachCheck.Reportf(pass, t.pos, "%s has the marker %q, which can only be used on lists and maps, but has the type %s", t.description, marker.Identifier, typeString(pass, t.typ))
+			return
+		}
+
+		next = target{description: t.description, pos: t.pos, typ: elem}
+	case markers.K8sEachKeyMarker:
+		mapType, ok := t.typ.Underlying().(*types.Map)
+		if !ok {
+			eachCheck.Reportf(pass, t.pos, "%s has the marker %q, which can only be used on maps, but has the type %s", t.description, marker.Identifier, typeString(pass, t.typ))
+			return
+		}
+
+		next = target{description: t.description, pos: t.pos, typ: mapType.Key()}
+	case markers.K8sItemMarker:
+		elem, ok := a.checkItem(pass, t, marker)
+		if !ok {
+			return
+		}
+
+		next = target{description: t.description, pos: t.pos, typ: elem}
+	case markers.K8sSubfieldMarker:
+		fieldType, ok := checkSubfield(pass, t, marker)
+		if !ok {
+			return
+		}
+
+		next = target{description: t.description, pos: t.pos, typ: fieldType}
+	case markers.K8sFormatMarker:
+		if !a.formats.Has(marker.Payload.Value) {
+			formatCheck.Reportf(pass, t.pos, "%s has the marker %q with the unsupported format %q, must be one of %s", t.description, marker.Identifier, marker.Payload.Value, quotedList(sets.List(a.formats)))
+		}
+	}
+
+	if marker.Payload.Marker != nil {
+		a.checkMarker(pass, next, marker.Payload.Marker)
+	}
+}
+
+// checkItem checks that the `+k8s:item` marker is used on a list of structs,
+// and that each of its selectors names a key of the list, and a field of the list items.
+// It returns the type of the list items.
+func (a *analyzer) checkItem(pass *analysis.Pass, t target, marker *markershelper.Marker) (types.Type, bool) {
+	slice, ok := t.typ.Underlying().(*types.Slice)
+	if !ok {
+		itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q, which can only be used on lists, but has the type %s", t.description, marker.Identifier, typeString(pass, t.typ))
+		return nil, false
+	}
+
+	itemStruct, ok := utils.DerefType(slice.Elem()).Underlying().(*types.Struct)
+	if !ok {
+		itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q, which can only be used on lists of structs, but has the type %s", t.description, marker.Identifier, typeString(pass, t.typ))
+		return nil, false
+	}
+
+	if len(marker.Arguments) == 0 {
+		itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q, which must select the item by the values of its key fields", t.description, marker.Identifier)
+		return slice.Elem(), true
+	}
+
+	if t.listMapKeys != nil && len(t.listMapKeys) == 0 {
+		itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q, but no %q markers naming the keys of the list", t.description, marker.Identifier, markers.K8sListMapKeyMarker)
+	}
+
+	itemFields := utils.SerializedStructFields(itemStruct)
+
+	for _, selector := range sortedKeys(marker.Arguments) {
+		switch {
+		case selector == "":
+			itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q, which must select the item by the names of its key fields", t.description, marker.Identifier)
+		case itemFields[selector] == nil:
+			itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q selecting the key %q, but the list item type %s has no field with that JSON name", t.description, marker.Identifier, selector, typeString(pass, slice.Elem()))
+		case len(t.listMapKeys) > 0 && !slices.Contains(t.listMapKeys, selector):
+			itemSelectorCheck.Reportf(pass, t.pos, "%s has the marker %q selecting the key %q, but %q is not a list map key, must be one of %s", t.description, marker.Identifier, selector, selector, quotedList(t.listMapKeys))
+		}
+	}
+
+	return slice.Elem(), true
+}
+
+// checkSubfield checks that the `+k8s:subfield` marker is used on a struct with a field of the given JSON name.
+// It returns the type of the field.
+func checkSubfield(pass *analysis.Pass, t target, marker *markershelper.Marker) (types.Type, bool) {
+	structType, ok := utils.DerefType(t.typ).Underlying().(*types.Struct)
+	if !ok {
+		subfieldCheck.Reportf(pass, t.pos, "%s has the marker %q, which can only be used on structs, but has the type %s", t.description, marker.Identifier, typeString(pass, t.typ))
+		return nil, false
+	}
+
+	fieldName := marker.Arguments[""]
+	if fieldName == "" {
+		subfieldCheck.Reportf(pass, t.pos, "%s has the marker %q, which must name the JSON field that it applies to", t.description, marker.Identifier)
+		return nil, false
+	}
+
+	field, ok := utils.SerializedStructFields(structType)[fieldName]
+	if !ok {
+		subfieldCheck.Reportf(pass, t.pos, "%s has the marker %q naming the field %q, but %s has no field with that JSON name", t.description, marker.Identifier, fieldName, typeString(pass, t.typ))
+		return nil, false
+	}
+
+	return field.Type(), true
+}
+
+// elemType returns the type of the values of a list or map.
+func elemType(typ types.Type) (types.Type, bool) {
+	switch t := typ.Underlying().(type) {
+	case *types.Slice:
+		return t.Elem(), true
+	case *types.Array:
+		return t.Elem(), true
+	case *types.Map:
+		return t.Elem(), true
+	default:
+		return nil, false
+	}
+}
+
+// typeString returns the type as written within the package being analyzed.
+func typeString(pass *analysis.Pass, typ types.Type) string {
+	return types.TypeString(typ, types.RelativeTo(pass.Pkg))
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// quotedList returns the values quoted, and separated by commas.
+func quotedList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
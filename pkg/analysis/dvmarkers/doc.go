@@ -0,0 +1,30 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `dvmarkers` linter checks that `+k8s:` declarative validation markers are used with types and values that they support.
+
+Markers are checked against the type of the field or type that they are declared on.
+Markers nested within the payload of another marker are checked against the type that the outer marker selects,
+for example, the items of a list for `+k8s:eachVal`, or the named field for `+k8s:subfield`.
+
+The linter checks that:
+  - `+k8s:listType=map` is accompanied by `+k8s:listMapKey`. The keys of a list field may also be declared on its named list type.
+  - `+k8s:item` is used on a list of structs, and its selectors name list map keys that are fields of the list items.
+  - `+k8s:eachVal` is used on a list or map, and `+k8s:eachKey` is used on a map.
+  - `+k8s:subfield` is used on a struct, and names one of its JSON fields.
+  - `+k8s:format` uses a format supported by declarative validation, or one of the configured additional formats.
+*/
+package dvmarkers
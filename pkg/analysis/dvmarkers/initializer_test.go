@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dvmarkers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/dvmarkers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+)
+
+var _ = Describe("dvmarkers initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      dvmarkers.DVMarkersConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := dvmarkers.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("dvmarkers"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid DVMarkersConfig", testCase{
+				config:      dvmarkers.DVMarkersConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid DVMarkersConfig: AdditionalFormats", testCase{
+				config: dvmarkers.DVMarkersConfig{
+					AdditionalFormats: []string{"k8s-custom", "k8s-other"},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid DVMarkersConfig: AdditionalFormats: empty format", testCase{
+				config: dvmarkers.DVMarkersConfig{
+					AdditionalFormats: []string{""},
+				},
+				expectedErr: "dvmarkers.additionalFormats[0]: Invalid value: \"\": format cannot be empty",
+			}),
+			Entry("With an invalid DVMarkersConfig: AdditionalFormats: duplicate format", testCase{
+				config: dvmarkers.DVMarkersConfig{
+					AdditionalFormats: []string{"k8s-custom", "k8s-custom"},
+				},
+				expectedErr: "dvmarkers.additionalFormats[1]: Duplicate value: \"k8s-custom\"",
+			}),
+			Entry("With a valid DVMarkersConfig: Checks: disable format", testCase{
+				config: dvmarkers.DVMarkersConfig{
+					Checks: checks.Config{
+						Disable: []string{"format"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid DVMarkersConfig: Checks: unknown check", testCase{
+				config: dvmarkers.DVMarkersConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "dvmarkers.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: each,format,item-selector,list-map-key,subfield",
+			}),
+		)
+	})
+})
@@ -0,0 +1,146 @@
+package a
+
+type ListMapKeys struct {
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	Valid []Item `json:"valid,omitempty"`
+
+	// +k8s:listType=map
+	MissingListMapKey []Item `json:"missingListMapKey,omitempty"` // want "field ListMapKeys.MissingListMapKey has the marker \"k8s:listType=map\", but no \"k8s:listMapKey\" marker naming the keys of the list"
+
+	// +k8s:listType=atomic
+	Atomic []Item `json:"atomic,omitempty"`
+}
+
+type Items struct {
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	// +k8s:item(name: "primary")=+k8s:immutable
+	Valid []Item `json:"valid,omitempty"`
+
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	// +k8s:listMapKey=namespace
+	// +k8s:item(name: "primary", namespace: "default")=+k8s:immutable
+	ValidMultipleKeys []Item `json:"validMultipleKeys,omitempty"`
+
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	// +k8s:item(namespace: "default")=+k8s:immutable
+	NotAKey []Item `json:"notAKey,omitempty"` // want "field Items.NotAKey has the marker \"k8s:item\" selecting the key \"namespace\", but \"namespace\" is not a list map key, must be one of \"name\""
+
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	// +k8s:item(missing: "value")=+k8s:immutable
+	UnknownField []Item `json:"unknownField,omitempty"` // want "field Items.UnknownField has the marker \"k8s:item\" selecting the key \"missing\", but the list item type Item has no field with that JSON name"
+
+	// +k8s:listType=atomic
+	// +k8s:item(name: "primary")=+k8s:immutable
+	NoKeys []Item `json:"noKeys,omitempty"` // want "field Items.NoKeys has the marker \"k8s:item\", but no \"k8s:listMapKey\" markers naming the keys of the list"
+
+	// +k8s:item(name: "primary")=+k8s:immutable
+	NotAList Item `json:"notAList,omitempty"` // want "field Items.NotAList has the marker \"k8s:item\", which can only be used on lists, but has the type Item"
+
+	// +k8s:item(name: "primary")=+k8s:immutable
+	NotAListOfStructs []string `json:"notAListOfStructs,omitempty"` // want "field Items.NotAListOfStructs has the marker \"k8s:item\", which can only be used on lists of structs, but has the type \\[\\]string"
+}
+
+// +k8s:listType=map
+// +k8s:listMapKey=name
+type ItemList []Item
+
+type NamedListItems struct {
+	// +k8s:item(name: "primary")=+k8s:immutable
+	Valid ItemList `json:"valid,omitempty"`
+
+	// +k8s:listType=map
+	// +k8s:item(name: "primary")=+k8s:immutable
+	ValidWithListType ItemList `json:"validWithListType,omitempty"`
+
+	// +k8s:item(namespace: "default")=+k8s:immutable
+	NotAKey ItemList `json:"notAKey,omitempty"` // want "field NamedListItems.NotAKey has the marker \"k8s:item\" selecting the key \"namespace\", but \"namespace\" is not a list map key, must be one of \"name\""
+}
+
+type Each struct {
+	// +k8s:eachVal=+k8s:minLength=1
+	ValidList []string `json:"validList,omitempty"`
+
+	// +k8s:eachVal=+k8s:minLength=1
+	// +k8s:eachKey=+k8s:minLength=1
+	ValidMap map[string]string `json:"validMap,omitempty"`
+
+	// +k8s:eachVal=+k8s:minLength=1
+	ValidNamedList Names `json:"validNamedList,omitempty"`
+
+	// +k8s:eachVal=+k8s:minLength=1
+	ValNotACollection string `json:"valNotACollection,omitempty"` // want "field Each.ValNotACollection has the marker \"k8s:eachVal\", which can only be used on lists and maps, but has the type string"
+
+	// +k8s:eachKey=+k8s:minLength=1
+	KeyNotAMap []string `json:"keyNotAMap,omitempty"` // want "field Each.KeyNotAMap has the marker \"k8s:eachKey\", which can only be used on maps, but has the type \\[\\]string"
+
+	// +k8s:eachVal=+k8s:eachVal=+k8s:minLength=1
+	NestedNotACollection []string `json:"nestedNotACollection,omitempty"` // want "field Each.NestedNotACollection has the marker \"k8s:eachVal\", which can only be used on lists and maps, but has the type string"
+
+	// +k8s:ifEnabled("my-feature")=+k8s:eachVal=+k8s:minLength=1
+	IfEnabledNotACollection string `json:"ifEnabledNotACollection,omitempty"` // want "field Each.IfEnabledNotACollection has the marker \"k8s:eachVal\", which can only be used on lists and maps, but has the type string"
+}
+
+// +k8s:eachVal=+k8s:minLength=1
+type Names []string
+
+// +k8s:eachVal=+k8s:minLength=1
+type NotNames string // want "type NotNames has the marker \"k8s:eachVal\", which can only be used on lists and maps, but has the type string"
+
+type Subfields struct {
+	// +k8s:subfield(name)=+k8s:minLength=1
+	Valid Item `json:"valid,omitempty"`
+
+	// +k8s:subfield(name)=+k8s:minLength=1
+	ValidPointer *Item `json:"validPointer,omitempty"`
+
+	// +k8s:subfield(inlined)=+k8s:minLength=1
+	ValidEmbedded Outer `json:"validEmbedded,omitempty"`
+
+	// +k8s:eachVal=+k8s:subfield(name)=+k8s:minLength=1
+	ValidEachVal []Item `json:"validEachVal,omitempty"`
+
+	// +k8s:subfield(Name)=+k8s:minLength=1
+	GoName Item `json:"goName,omitempty"` // want "field Subfields.GoName has the marker \"k8s:subfield\" naming the field \"Name\", but Item has no field with that JSON name"
+
+	// +k8s:subfield(name)=+k8s:minLength=1
+	NotAStruct string `json:"notAStruct,omitempty"` // want "field Subfields.NotAStruct has the marker \"k8s:subfield\", which can only be used on structs, but has the type string"
+
+	// +k8s:subfield(name)=+k8s:subfield(missing)=+k8s:minLength=1
+	NestedNotAStruct Item `json:"nestedNotAStruct,omitempty"` // want "field Subfields.NestedNotAStruct has the marker \"k8s:subfield\", which can only be used on structs, but has the type string"
+
+	// +k8s:eachVal=+k8s:subfield(missing)=+k8s:minLength=1
+	EachValMissing []Item `json:"eachValMissing,omitempty"` // want "field Subfields.EachValMissing has the marker \"k8s:subfield\" naming the field \"missing\", but Item has no field with that JSON name"
+}
+
+type Formats struct {
+	// +k8s:format=k8s-short-name
+	Valid string `json:"valid,omitempty"`
+
+	// +k8s:format=k8s-custom
+	Custom string `json:"custom,omitempty"`
+
+	// +k8s:format=dns-label
+	Unsupported string `json:"unsupported,omitempty"` // want "field Formats.Unsupported has the marker \"k8s:format\" with the unsupported format \"dns-label\", must be one of \"k8s-custom\", \"k8s-extended-resource-name\", \"k8s-ip\", \"k8s-label-key\", \"k8s-label-value\", \"k8s-long-name\", \"k8s-long-name-caseless\", \"k8s-resource-fully-qualified-name\", \"k8s-resource-pool-name\", \"k8s-short-name\", \"k8s-uuid\""
+
+	// +k8s:eachVal=+k8s:format=ip
+	UnsupportedEachVal []string `json:"unsupportedEachVal,omitempty"` // want "field Formats.UnsupportedEachVal has the marker \"k8s:format\" with the unsupported format \"ip\""
+}
+
+type Item struct {
+	Name string `json:"name"`
+
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type Outer struct {
+	Inner `json:",inline"`
+}
+
+type Inner struct {
+	Inlined string `json:"inlined,omitempty"`
+}
@@ -0,0 +1,502 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvmigration
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const name = "dvmigration"
+
+//nolint:gochecknoglobals
+var (
+	missingCounterpartCheck = checks.New(name, "missing-counterpart", config.SeverityWarning)
+	valueMismatchCheck      = checks.New(name, "value-mismatch", config.SeverityError)
+	disallowedMarkerCheck   = checks.New(name, "disallowed-marker", config.SeverityWarning)
+)
+
+// markerMapping maps kubebuilder markers to their declarative validation equivalent.
+type markerMapping struct {
+	// kubebuilder are the identifiers of the equivalent kubebuilder markers.
+	// The first identifier is used when adding a kubebuilder marker.
+	kubebuilder []string
+
+	// declarative is the identifier of the declarative validation marker.
+	declarative string
+
+	// integer determines whether the declarative validation marker only accepts integer values.
+	integer bool
+}
+
+//nolint:gochecknoglobals
+var mappings = []markerMapping{
+	{kubebuilder: []string{markers.OptionalMarker, markers.KubebuilderOptionalMarker}, declarative: markers.K8sOptionalMarker},
+	{kubebuilder: []string{markers.RequiredMarker, markers.KubebuilderRequiredMarker}, declarative: markers.K8sRequiredMarker},
+	{kubebuilder: []string{markers.KubebuilderMinLengthMarker}, declarative: markers.K8sMinLengthMarker},
+	{kubebuilder: []string{markers.KubebuilderMaxLengthMarker}, declarative: markers.K8sMaxLengthMarker},
+	{kubebuilder: []string{markers.KubebuilderMinItemsMarker}, declarative: markers.K8sMinItemsMarker},
+	{kubebuilder: []string{markers.KubebuilderMaxItemsMarker}, declarative: markers.K8sMaxItemsMarker},
+	{kubebuilder: []string{markers.KubebuilderMinimumMarker}, declarative: markers.K8sMinimumMarker, integer: true},
+	{kubebuilder: []string{markers.KubebuilderMaximumMarker}, declarative: markers.K8sMaximumMarker, integer: true},
+	{kubebuilder: []string{markers.KubebuilderListTypeMarker}, declarative: markers.K8sListTypeMarker},
+	{kubebuilder: []string{markers.KubebuilderListMapKeyMarker}, declarative: markers.K8sListMapKeyMarker},
+	{kubebuilder: []string{markers.UnionDiscriminatorMarker}, declarative: markers.K8sUnionDiscriminatorMarker},
+}
+
+func init() {
+	checks.DefaultRegistry().Register(missingCounterpartCheck, valueMismatchCheck, disallowedMarkerCheck)
+
+	for _, mapping := range mappings {
+		markershelper.DefaultRegistry().Register(mapping.kubebuilder...)
+		markershelper.DefaultRegistry().Register(mapping.declarative)
+	}
+
+	markershelper.DefaultRegistry().Register(markers.KubebuilderEnumMarker, markers.K8sEnumMarker)
+}
+
+type analyzer struct {
+	mode   DVMigrationMode
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *DVMigrationConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &DVMigrationConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		mode:   cfg.Mode,
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that kubebuilder markers and their declarative validation equivalents are used consistently while migrating to declarative validation.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+// element is a field or type that the markers are declared on.
+type element struct {
+	kind string
+	name string
+	node ast.Node
+}
+
+// markerGroup is the set of markers from a single family that apply a constraint to an element.
+type markerGroup struct {
+	// identifier is the identifier of the markers, used within messages.
+	identifier string
+
+	// markers are the markers within the group, in the order they are declared.
+	markers []markershelper.Marker
+
+	// values are the normalized, sorted and deduplicated values of the markers.
+	values []string
+}
+
+// markerPair is the kubebuilder and declarative validation markers for a single constraint on an element,
+// along with the markers of each family rewritten as the other.
+type markerPair struct {
+	kubebuilder markerGroup
+	declarative markerGroup
+
+	// asDeclarative are the declarative validation markers equivalent to the kubebuilder markers.
+	// When empty, the declarative validation markers cannot be written from the kubebuilder markers.
+	asDeclarative []string
+
+	// asKubebuilder are the kubebuilder markers equivalent to the declarative validation markers.
+	// When empty, the kubebuilder markers cannot be written from the declarative validation markers.
+	asKubebuilder []string
+
+	// fixMismatch determines whether the declarative validation markers can be rewritten
+	// from the kubebuilder markers when their values do not match.
+	fixMismatch bool
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		el := element{kind: "field", name: qualifiedFieldName, node: field}
+
+		for _, mapping := range mappings {
+			a.checkPair(pass, el, newMarkerPair(mapping, markersAccess.FieldMarkers(field)))
+		}
+	})
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		el := element{kind: "type", name: typeSpec.Name.Name, node: typeSpec}
+		typeMarkers := markersAccess.TypeMarkers(typeSpec)
+
+		for _, mapping := range mappings {
+			a.checkPair(pass, el, newMarkerPair(mapping, typeMarkers))
+		}
+
+		a.checkPair(pass, el, newEnumPair(pass, typeSpec, typeMarkers))
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// newMarkerPair builds the marker pair for the mapping from the markers declared on an element.
+func newMarkerPair(mapping markerMapping, markerSet markershelper.MarkerSet) markerPair {
+	pair := markerPair{
+		kubebuilder: markerGroup{identifier: mapping.kubebuilder[0]},
+		declarative: markerGroup{identifier: mapping.declarative},
+	}
+
+	for _, identifier := range mapping.kubebuilder {
+		pair.kubebuilder.markers = append(pair.kubebuilder.markers, markerSet.Get(identifier)...)
+	}
+
+	pair.declarative.markers = markerSet.Get(mapping.declarative)
+
+	for _, group := range []*markerGroup{&pair.kubebuilder, &pair.declarative} {
+		slices.SortFunc(group.markers, func(a, b markershelper.Marker) int {
+			return int(a.Pos - b.Pos)
+		})
+
+		if len(group.markers) > 0 {
+			group.identifier = group.markers[0].Identifier
+		}
+
+		for _, marker := range group.markers {
+			group.values = append(group.values, normalizeValue(marker.Payload.Value))
+		}
+
+		group.values = sortedUnique(group.values)
+	}
+
+	pair.asDeclarative = asDeclarativeMarkers(mapping, pair.kubebuilder.markers)
+	pair.fixMismatch = len(pair.asDeclarative) > 0
+
+	for _, marker := range pair.declarative.markers {
+		pair.asKubebuilder = append(pair.asKubebuilder, markerText(mapping.kubebuilder[0], marker.Payload.Value))
+	}
+
+	return pair
+}
+
+// asDeclarativeMarkers returns the declarative validation markers equivalent to the kubebuilder markers.
+// Where the declarative validation marker only accepts integers, such as `+k8s:minimum`, and any of the kubebuilder markers
+// has a value that is not an integer, no markers are returned, as the kubebuilder markers cannot be rewritten.
+func asDeclarativeMarkers(mapping markerMapping, kubebuilderMarkers []markershelper.Marker) []string {
+	declarativeMarkers := make([]string, 0, len(kubebuilderMarkers))
+
+	for _, marker := range kubebuilderMarkers {
+		value := marker.Payload.Value
+
+		if mapping.integer {
+			value = normalizeValue(value)
+
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return nil
+			}
+		}
+
+		declarativeMarkers = append(declarativeMarkers, markerText(mapping.declarative, value))
+	}
+
+	return declarativeMarkers
+}
+
+// newEnumPair builds the marker pair for the enum markers of a type.
+// The values of the `+kubebuilder:validation:Enum` marker are listed within the marker,
+// whereas the values of a type with the `+k8s:enum` marker are the constants declared for the type.
+// The `+k8s:enum` marker is only written from the kubebuilder marker when the constants declared
+// for the type match the values of the kubebuilder marker.
+func newEnumPair(pass *analysis.Pass, typeSpec *ast.TypeSpec, markerSet markershelper.MarkerSet) markerPair {
+	pair := markerPair{
+		kubebuilder: markerGroup{identifier: markers.KubebuilderEnumMarker, markers: markerSet.Get(markers.KubebuilderEnumMarker)},
+		declarative: markerGroup{identifier: markers.K8sEnumMarker, markers: markerSet.Get(markers.K8sEnumMarker)},
+	}
+
+	for _, marker := range pair.kubebuilder.markers {
+		for _, value := range strings.Split(marker.Payload.Value, ";") {
+			if value = strings.Trim(strings.TrimSpace(value), `"`); value != "" {
+				pair.kubebuilder.values = append(pair.kubebuilder.values, value)
+			}
+		}
+	}
+
+	pair.kubebuilder.values = sortedUnique(pair.kubebuilder.values)
+
+	constValues := enumConstValues(pass, typeSpec)
+	pair.declarative.values = sortedUnique(slices.Clone(constValues))
+
+	if len(pair.kubebuilder.markers) > 0 && len(constValues) > 0 && slices.Equal(pair.kubebuilder.values, pair.declarative.values) {
+		pair.asDeclarative = []string{markerText(markers.K8sEnumMarker, "")}
+	}
+
+	if len(pair.declarative.markers) > 0 && len(constValues) > 0 {
+		pair.asKubebuilder = []string{markerText(markers.KubebuilderEnumMarker, strings.Join(constValues, ";"))}
+	}
+
+	return pair
+}
+
+// checkPair checks the markers of a single constraint on an element according to the configured mode.
+func (a *analyzer) checkPair(pass *analysis.Pass, el element, pair markerPair) {
+	kubebuilder, declarative := pair.kubebuilder, pair.declarative
+
+	switch a.mode {
+	case DVMigrationModeDualWrite:
+		switch {
+		case len(kubebuilder.markers) == 0 && len(declarative.markers) == 0:
+			return
+		case len(declarative.markers) == 0:
+			missingCounterpartCheck.Report(pass, diagnostic(el,
+				fmt.Sprintf("%s %s has the marker %q, but not its declarative validation equivalent %q", el.kind, el.name, kubebuilder.identifier, declarative.identifier),
+				"add the declarative validation marker",
+				insertAfter(pass, kubebuilder.markers, pair.asDeclarative)...,
+			))
+		case len(kubebuilder.markers) == 0:
+			missingCounterpartCheck.Report(pass, diagnostic(el,
+				fmt.Sprintf("%s %s has the declarative validation marker %q, but not its kubebuilder equivalent %q", el.kind, el.name, declarative.identifier, kubebuilder.identifier),
+				"add the kubebuilder marker",
+				insertAfter(pass, declarative.markers, pair.asKubebuilder)...,
+			))
+		case !slices.Equal(kubebuilder.values, declarative.values):
+			var edits []analysis.TextEdit
+			if pair.fixMismatch {
+				edits = append(removeLines(pass, declarative.markers), insertAfter(pass, kubebuilder.markers, pair.asDeclarative)...)
+			}
+
+			valueMismatchCheck.Report(pass, diagnostic(el,
+				fmt.Sprintf("%s %s has the marker %q with %s, but the declarative validation marker %q with %s", el.kind, el.name, kubebuilder.identifier, describeValues(kubebuilder.values), declarative.identifier, describeValues(declarative.values)),
+				"update the declarative validation marker to match the kubebuilder marker",
+				edits...,
+			))
+		}
+	case DVMigrationModeKubebuilderOnly:
+		if len(declarative.markers) == 0 {
+			return
+		}
+
+		disallowedMarkerCheck.Report(pass, diagnostic(el,
+			fmt.Sprintf("%s %s has the declarative validation marker %q, but only kubebuilder markers should be used", el.kind, el.name, declarative.identifier),
+			fmt.Sprintf("replace with the kubebuilder marker %q", kubebuilder.identifier),
+			replace(pass, declarative.markers, kubebuilder.markers, pair.asKubebuilder)...,
+		))
+	case DVMigrationModeDeclarativeValidationOnly:
+		if len(kubebuilder.markers) == 0 {
+			return
+		}
+
+		disallowedMarkerCheck.Report(pass, diagnostic(el,
+			fmt.Sprintf("%s %s has the marker %q, but only declarative validation markers should be used", el.kind, el.name, kubebuilder.identifier),
+			fmt.Sprintf("replace with the declarative validation marker %q", declarative.identifier),
+			replace(pass, kubebuilder.markers, declarative.markers, pair.asDeclarative)...,
+		))
+	}
+}
+
+// diagnostic builds a diagnostic for the element, with a suggested fix when there are edits to apply.
+func diagnostic(el element, message, fixMessage string, edits ...analysis.TextEdit) analysis.Diagnostic {
+	diag := analysis.Diagnostic{
+		Pos:     el.node.Pos(),
+		Message: message,
+	}
+
+	if len(edits) > 0 {
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message:   fixMessage,
+				TextEdits: edits,
+			},
+		}
+	}
+
+	return diag
+}
+
+// replace returns the edits replacing the disallowed markers.
+// When the element already has the equivalent markers, the disallowed markers are removed.
+// Otherwise, the first disallowed marker is replaced with the equivalent markers, and the others are removed.
+// No edits are returned when the equivalent markers cannot be written.
+func replace(pass *analysis.Pass, disallowed, equivalent []markershelper.Marker, replacement []string) []analysis.TextEdit {
+	if len(equivalent) > 0 {
+		return removeLines(pass, disallowed)
+	}
+
+	if len(replacement) == 0 {
+		return nil
+	}
+
+	return append([]analysis.TextEdit{
+		{
+			Pos:     disallowed[0].Pos,
+			End:     disallowed[0].End,
+			NewText: []byte(strings.Join(replacement, "\n"+indentation(pass, disallowed[0]))),
+		},
+	}, removeLines(pass, disallowed[1:])...)
+}
+
+// insertAfter returns the edit inserting the new markers on the lines after the last of the existing markers,
+// with the same indentation.
+// No edits are returned when there are no markers to insert.
+func insertAfter(pass *analysis.Pass, existing []markershelper.Marker, newMarkers []string) []analysis.TextEdit {
+	if len(newMarkers) == 0 {
+		return nil
+	}
+
+	last := existing[len(existing)-1]
+	indent := indentation(pass, last)
+
+	var text strings.Builder
+	for _, newMarker := range newMarkers {
+		text.WriteString("\n" + indent + newMarker)
+	}
+
+	return []analysis.TextEdit{
+		{
+			Pos:     last.End,
+			End:     last.End,
+			NewText: []byte(text.String()),
+		},
+	}
+}
+
+// removeLines returns the edits removing the lines that the markers are declared on.
+func removeLines(pass *analysis.Pass, toRemove []markershelper.Marker) []analysis.TextEdit {
+	edits := make([]analysis.TextEdit, 0, len(toRemove))
+
+	for _, marker := range toRemove {
+		file := pass.Fset.File(marker.Pos)
+
+		edits = append(edits, analysis.TextEdit{
+			Pos: file.LineStart(file.Line(marker.Pos)),
+			End: marker.End + 1,
+		})
+	}
+
+	return edits
+}
+
+// indentation returns the indentation of the line that the marker is declared on.
+func indentation(pass *analysis.Pass, marker markershelper.Marker) string {
+	return strings.Repeat("\t", pass.Fset.Position(marker.Pos).Column-1)
+}
+
+// enumConstValues returns the values of the constants declared with the type, in the order they are declared.
+func enumConstValues(pass *analysis.Pass, typeSpec *ast.TypeSpec) []string {
+	obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	consts := []*types.Const{}
+
+	for _, constName := range pass.Pkg.Scope().Names() {
+		c, ok := pass.Pkg.Scope().Lookup(constName).(*types.Const)
+		if ok && types.Identical(c.Type(), obj.Type()) {
+			consts = append(consts, c)
+		}
+	}
+
+	slices.SortFunc(consts, func(a, b *types.Const) int {
+		return int(a.Pos() - b.Pos())
+	})
+
+	values := make([]string, 0, len(consts))
+
+	for _, c := range consts {
+		if c.Val().Kind() == constant.String {
+			values = append(values, constant.StringVal(c.Val()))
+		} else {
+			values = append(values, c.Val().ExactString())
+		}
+	}
+
+	return values
+}
+
+// markerText returns the comment declaring the marker with the given value.
+func markerText(identifier, value string) string {
+	if value == "" {
+		return "// +" + identifier
+	}
+
+	return fmt.Sprintf("// +%s=%s", identifier, value)
+}
+
+// normalizeValue normalizes the marker value so that equivalent values compare as equal,
+// e.g. `"atomic"` and `atomic`, or `1.0` and `1`.
+func normalizeValue(value string) string {
+	value = strings.Trim(strings.TrimSpace(value), "\"`")
+
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		return strconv.FormatFloat(number, 'g', -1, 64)
+	}
+
+	return value
+}
+
+// sortedUnique returns the sorted values, without duplicates.
+func sortedUnique(values []string) []string {
+	slices.Sort(values)
+
+	return slices.Compact(values)
+}
+
+// describeValues describes the values of a marker group within a message.
+func describeValues(values []string) string {
+	switch len(values) {
+	case 0:
+		return "no values"
+	case 1:
+		return fmt.Sprintf("the value %q", values[0])
+	default:
+		quoted := make([]string, 0, len(values))
+		for _, value := range values {
+			quoted = append(quoted, strconv.Quote(value))
+		}
+
+		return "the values " + strings.Join(quoted, ", ")
+	}
+}
+
+func defaultConfig(cfg *DVMigrationConfig) {
+	if cfg.Mode == "" {
+		cfg.Mode = DVMigrationModeDualWrite
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvmigration
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// DVMigrationMode is the set of marker families that fields and types should use.
+type DVMigrationMode string
+
+const (
+	// DVMigrationModeDualWrite indicates that every kubebuilder marker should have its
+	// declarative validation equivalent, and vice versa, with matching values.
+	DVMigrationModeDualWrite DVMigrationMode = "DualWrite"
+
+	// DVMigrationModeKubebuilderOnly indicates that only kubebuilder markers should be used.
+	DVMigrationModeKubebuilderOnly DVMigrationMode = "KubebuilderOnly"
+
+	// DVMigrationModeDeclarativeValidationOnly indicates that only `+k8s:` declarative validation markers should be used.
+	DVMigrationModeDeclarativeValidationOnly DVMigrationMode = "DeclarativeValidationOnly"
+)
+
+// DVMigrationConfig contains configuration for the dvmigration linter.
+type DVMigrationConfig struct {
+	// mode is the set of marker families that fields and types should use.
+	// Valid values are "DualWrite", "KubebuilderOnly" and "DeclarativeValidationOnly".
+	// When set to "DualWrite", markers missing their equivalent in the other family are reported,
+	// as are equivalent markers with different values.
+	// When set to "KubebuilderOnly", declarative validation markers are reported, with a fix to use the kubebuilder equivalent.
+	// When set to "DeclarativeValidationOnly", kubebuilder markers are reported, with a fix to use the declarative validation equivalent.
+	// When otherwise not specified, the default value is "DualWrite".
+	Mode DVMigrationMode `json:"mode"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `value-mismatch`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
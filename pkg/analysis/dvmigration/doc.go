@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `dvmigration` linter helps migrate APIs from kubebuilder markers to `+k8s:` declarative validation markers.
+
+Each kubebuilder marker is mapped to its declarative validation equivalent:
+
+  - `+optional` and `+kubebuilder:validation:Optional` to `+k8s:optional`
+  - `+required` and `+kubebuilder:validation:Required` to `+k8s:required`
+  - `+kubebuilder:validation:MinLength` and `+kubebuilder:validation:MaxLength` to `+k8s:minLength` and `+k8s:maxLength`
+  - `+kubebuilder:validation:MinItems` and `+kubebuilder:validation:MaxItems` to `+k8s:minItems` and `+k8s:maxItems`
+  - `+kubebuilder:validation:Minimum` and `+kubebuilder:validation:Maximum` to `+k8s:minimum` and `+k8s:maximum`
+  - `+listType` and `+listMapKey` to `+k8s:listType` and `+k8s:listMapKey`
+  - `+unionDiscriminator` to `+k8s:unionDiscriminator`
+  - `+kubebuilder:validation:Enum` on a type to `+k8s:enum`, whose values are the constants declared for the type
+
+In the default `DualWrite` mode, the linter reports markers missing their equivalent in the other family,
+and equivalent markers with different values. The kubebuilder markers are treated as the source of truth,
+so fixes rewrite the declarative validation markers to match them.
+Mismatched enum values are reported without a fix, as the constants of the type cannot be rewritten.
+The `+k8s:enum` marker is only added when the constants declared for the type match the kubebuilder enum values,
+and `+k8s:minimum` and `+k8s:maximum` only accept integers, so non-integer bounds are reported without a fix.
+
+In the `KubebuilderOnly` and `DeclarativeValidationOnly` modes, the linter reports markers from the other family,
+with a fix to replace them with their equivalent, or to remove them when the equivalent is already present.
+*/
+package dvmigration
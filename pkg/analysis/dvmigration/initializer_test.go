@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dvmigration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/dvmigration"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+)
+
+var _ = Describe("dvmigration initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      dvmigration.DVMigrationConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := dvmigration.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("dvmigration"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid DVMigrationConfig", testCase{
+				config:      dvmigration.DVMigrationConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid DVMigrationConfig: Mode: DualWrite", testCase{
+				config: dvmigration.DVMigrationConfig{
+					Mode: dvmigration.DVMigrationModeDualWrite,
+				},
+				expectedErr: "",
+			}),
+			Entry("With a valid DVMigrationConfig: Mode: KubebuilderOnly", testCase{
+				config: dvmigration.DVMigrationConfig{
+					Mode: dvmigration.DVMigrationModeKubebuilderOnly,
+				},
+				expectedErr: "",
+			}),
+			Entry("With a valid DVMigrationConfig: Mode: DeclarativeValidationOnly", testCase{
+				config: dvmigration.DVMigrationConfig{
+					Mode: dvmigration.DVMigrationModeDeclarativeValidationOnly,
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid DVMigrationConfig: Mode: invalid", testCase{
+				config: dvmigration.DVMigrationConfig{
+					Mode: "invalid",
+				},
+				expectedErr: "dvmigration.mode: Invalid value: \"invalid\": invalid value, must be one of \"DualWrite\", \"KubebuilderOnly\", \"DeclarativeValidationOnly\" or omitted",
+			}),
+			Entry("With a valid DVMigrationConfig: Checks: disable value-mismatch", testCase{
+				config: dvmigration.DVMigrationConfig{
+					Checks: checks.Config{
+						Disable: []string{"value-mismatch"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid DVMigrationConfig: Checks: unknown check", testCase{
+				config: dvmigration.DVMigrationConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "dvmigration.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: disallowed-marker,missing-counterpart,value-mismatch",
+			}),
+		)
+	})
+})
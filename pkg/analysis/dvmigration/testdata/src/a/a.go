@@ -0,0 +1,110 @@
+package a
+
+type DualWrite struct {
+	// +optional
+	// +k8s:optional
+	Complete string `json:"complete,omitempty"`
+
+	// +required
+	MissingDeclarative string `json:"missingDeclarative"` // want "field DualWrite.MissingDeclarative has the marker \"required\", but not its declarative validation equivalent \"k8s:required\""
+
+	// +k8s:optional
+	MissingKubebuilder string `json:"missingKubebuilder,omitempty"` // want "field DualWrite.MissingKubebuilder has the declarative validation marker \"k8s:optional\", but not its kubebuilder equivalent \"optional\""
+
+	// +optional
+	// +k8s:optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +k8s:maxLength=253
+	MissingMinLength string `json:"missingMinLength,omitempty"` // want "field DualWrite.MissingMinLength has the marker \"kubebuilder:validation:MinLength\", but not its declarative validation equivalent \"k8s:minLength\""
+
+	// +optional
+	// +k8s:optional
+	// +kubebuilder:validation:MaxItems=10
+	// +k8s:maxItems=16
+	MismatchedMaxItems []string `json:"mismatchedMaxItems,omitempty"` // want "field DualWrite.MismatchedMaxItems has the marker \"kubebuilder:validation:MaxItems\" with the value \"10\", but the declarative validation marker \"k8s:maxItems\" with the value \"16\""
+
+	// +optional
+	// +k8s:optional
+	// +kubebuilder:validation:Minimum=1.0
+	// +k8s:minimum=1
+	EquivalentMinimum int32 `json:"equivalentMinimum,omitempty"`
+
+	// +optional
+	// +k8s:optional
+	// +kubebuilder:validation:Minimum=0.5
+	NonIntegerMinimum float64 `json:"nonIntegerMinimum,omitempty"` // want "field DualWrite.NonIntegerMinimum has the marker \"kubebuilder:validation:Minimum\", but not its declarative validation equivalent \"k8s:minimum\""
+
+	// +optional
+	// +k8s:optional
+	// +kubebuilder:validation:Maximum=2.5
+	// +k8s:maximum=2
+	MismatchedNonIntegerMaximum float64 `json:"mismatchedNonIntegerMaximum,omitempty"` // want "field DualWrite.MismatchedNonIntegerMaximum has the marker \"kubebuilder:validation:Maximum\" with the value \"2.5\", but the declarative validation marker \"k8s:maximum\" with the value \"2\""
+
+	// +optional
+	// +k8s:optional
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=namespace
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	MismatchedListMapKeys []Item `json:"mismatchedListMapKeys,omitempty"` // want "field DualWrite.MismatchedListMapKeys has the marker \"listMapKey\" with the values \"name\", \"namespace\", but the declarative validation marker \"k8s:listMapKey\" with the value \"name\""
+
+	// +kubebuilder:validation:Optional
+	MissingDeclarativeOptional string `json:"missingDeclarativeOptional,omitempty"` // want "field DualWrite.MissingDeclarativeOptional has the marker \"kubebuilder:validation:Optional\", but not its declarative validation equivalent \"k8s:optional\""
+}
+
+type Item struct {
+	// +required
+	// +k8s:required
+	Name string `json:"name"`
+
+	// +required
+	// +k8s:required
+	Namespace string `json:"namespace"`
+}
+
+// +kubebuilder:validation:Enum=Always;Never
+// +k8s:enum
+type CompleteEnum string
+
+const (
+	CompleteEnumAlways CompleteEnum = "Always"
+	CompleteEnumNever  CompleteEnum = "Never"
+)
+
+// +kubebuilder:validation:Enum=Red;Green
+type MissingDeclarativeEnum string // want "type MissingDeclarativeEnum has the marker \"kubebuilder:validation:Enum\", but not its declarative validation equivalent \"k8s:enum\""
+
+// +k8s:enum
+type MissingKubebuilderEnum string // want "type MissingKubebuilderEnum has the declarative validation marker \"k8s:enum\", but not its kubebuilder equivalent \"kubebuilder:validation:Enum\""
+
+const (
+	MissingKubebuilderEnumTCP MissingKubebuilderEnum = "TCP"
+	MissingKubebuilderEnumUDP MissingKubebuilderEnum = "UDP"
+)
+
+// +kubebuilder:validation:Enum=Small;Large
+// +k8s:enum
+type MismatchedEnum string // want "type MismatchedEnum has the marker \"kubebuilder:validation:Enum\" with the values \"Large\", \"Small\", but the declarative validation marker \"k8s:enum\" with the values \"Large\", \"Medium\", \"Small\""
+
+const (
+	MismatchedEnumSmall  MismatchedEnum = "Small"
+	MismatchedEnumMedium MismatchedEnum = "Medium"
+	MismatchedEnumLarge  MismatchedEnum = "Large"
+)
+
+// +kubebuilder:validation:Enum=Up;Down
+type MatchingConstantsEnum string // want "type MatchingConstantsEnum has the marker \"kubebuilder:validation:Enum\", but not its declarative validation equivalent \"k8s:enum\""
+
+const (
+	MatchingConstantsEnumUp   MatchingConstantsEnum = "Up"
+	MatchingConstantsEnumDown MatchingConstantsEnum = "Down"
+)
+
+// +kubebuilder:validation:Enum=Left;Right
+type MismatchedConstantsEnum string // want "type MismatchedConstantsEnum has the marker \"kubebuilder:validation:Enum\", but not its declarative validation equivalent \"k8s:enum\""
+
+const (
+	MismatchedConstantsEnumLeft MismatchedConstantsEnum = "Left"
+)
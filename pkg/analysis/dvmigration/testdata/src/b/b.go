@@ -0,0 +1,39 @@
+package b
+
+type KubebuilderOnly struct {
+	// +optional
+	Kubebuilder string `json:"kubebuilder,omitempty"`
+
+	// +optional
+	// +k8s:optional
+	DualWritten string `json:"dualWritten,omitempty"` // want "field KubebuilderOnly.DualWritten has the declarative validation marker \"k8s:optional\", but only kubebuilder markers should be used"
+
+	// +k8s:required
+	// +k8s:minLength=1
+	Declarative string `json:"declarative"` // want "field KubebuilderOnly.Declarative has the declarative validation marker \"k8s:required\", but only kubebuilder markers should be used" "field KubebuilderOnly.Declarative has the declarative validation marker \"k8s:minLength\", but only kubebuilder markers should be used"
+
+	// +optional
+	// +k8s:listType=map
+	// +k8s:listMapKey=name
+	// +k8s:listMapKey=namespace
+	Items []Item `json:"items,omitempty"` // want "field KubebuilderOnly.Items has the declarative validation marker \"k8s:listType\", but only kubebuilder markers should be used" "field KubebuilderOnly.Items has the declarative validation marker \"k8s:listMapKey\", but only kubebuilder markers should be used"
+}
+
+type Item struct {
+	// +required
+	Name string `json:"name"`
+
+	// +required
+	Namespace string `json:"namespace"`
+}
+
+// +k8s:enum
+type Protocol string // want "type Protocol has the declarative validation marker \"k8s:enum\", but only kubebuilder markers should be used"
+
+const (
+	ProtocolTCP Protocol = "TCP"
+	ProtocolUDP Protocol = "UDP"
+)
+
+// +k8s:enum
+type NoValues string // want "type NoValues has the declarative validation marker \"k8s:enum\", but only kubebuilder markers should be used"
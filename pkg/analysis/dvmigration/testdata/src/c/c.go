@@ -0,0 +1,36 @@
+package c
+
+type DeclarativeValidationOnly struct {
+	// +k8s:optional
+	Declarative string `json:"declarative,omitempty"`
+
+	// +optional
+	// +k8s:optional
+	DualWritten string `json:"dualWritten,omitempty"` // want "field DeclarativeValidationOnly.DualWritten has the marker \"optional\", but only declarative validation markers should be used"
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	Kubebuilder string `json:"kubebuilder"` // want "field DeclarativeValidationOnly.Kubebuilder has the marker \"kubebuilder:validation:Required\", but only declarative validation markers should be used" "field DeclarativeValidationOnly.Kubebuilder has the marker \"kubebuilder:validation:MaxLength\", but only declarative validation markers should be used"
+
+	// +k8s:optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"` // want "field DeclarativeValidationOnly.Port has the marker \"kubebuilder:validation:Minimum\", but only declarative validation markers should be used" "field DeclarativeValidationOnly.Port has the marker \"kubebuilder:validation:Maximum\", but only declarative validation markers should be used"
+}
+
+// +kubebuilder:validation:Enum=TCP;UDP
+type Protocol string // want "type Protocol has the marker \"kubebuilder:validation:Enum\", but only declarative validation markers should be used"
+
+const (
+	ProtocolTCP Protocol = "TCP"
+	ProtocolUDP Protocol = "UDP"
+)
+
+// +kubebuilder:validation:Enum=Small;Large
+// +k8s:enum
+type Size string // want "type Size has the marker \"kubebuilder:validation:Enum\", but only declarative validation markers should be used"
+
+const (
+	SizeSmall Size = "Small"
+	SizeLarge Size = "Large"
+)
@@ -32,4 +32,7 @@ var (
 
 	// ErrCouldNotGetJSONTags is returned when the JSON tags could not be retrieved.
 	ErrCouldNotGetJSONTags = errors.New("could not get json tags")
+
+	// ErrCouldNotGetResolvedDiagnostics is returned when the resolved diagnostics could not be retrieved.
+	ErrCouldNotGetResolvedDiagnostics = errors.New("could not get resolved diagnostics")
 )
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package featuregates
+
+import (
+	"go/ast"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const name = "featuregates"
+
+//nolint:gochecknoglobals
+var (
+	notOptionalCheck        = checks.New(name, "not-optional", config.SeverityError)
+	celRequiredCheck        = checks.New(name, "cel-required", config.SeverityError)
+	unionDiscriminatorCheck = checks.New(name, "union-discriminator", config.SeverityError)
+	unknownGateCheck        = checks.New(name, "unknown-gate", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(notOptionalCheck, celRequiredCheck, unionDiscriminatorCheck, unknownGateCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.FeatureGateMarker,
+		markers.K8sIfEnabledMarker,
+		markers.K8sIfDisabledMarker,
+		markers.UnionDiscriminatorMarker,
+		markers.K8sUnionDiscriminatorMarker,
+		markers.KubebuilderXValidationMarker,
+		markers.KubebuilderExactlyOneOf,
+		markers.KubebuilderAtLeastOneOfMarker,
+	)
+}
+
+type analyzer struct {
+	knownFeatureGates sets.Set[string]
+	checks            checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *FeatureGatesConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &FeatureGatesConfig{}
+	}
+
+	a := &analyzer{
+		knownFeatureGates: sets.New(cfg.KnownFeatureGates...),
+		checks:            cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that fields gated by a feature gate are optional, are not required by CEL rules, and are not union discriminators, as disabling the gate clears them.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer, extractjsontags.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	jsonTags, ok := pass.ResultOf[extractjsontags.Analyzer].(extractjsontags.StructFieldTags)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetJSONTags
+	}
+
+	inspect.InspectFieldsIncludingListTypes(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		gates := featureGates(markersAccess.FieldMarkers(field))
+		if len(gates) == 0 {
+			return
+		}
+
+		a.checkGatedField(pass, field, markersAccess, gates, qualifiedFieldName)
+	})
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		checkTypeRules(pass, typeSpec, markersAccess, jsonTags)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkGatedField checks that the gated field is optional, is not a union discriminator, and is gated by known feature gates.
+func (a *analyzer) checkGatedField(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, gates []string, qualifiedFieldName string) {
+	fieldMarkers := markersAccess.FieldMarkers(field)
+	gateNames := strings.Join(gates, ", ")
+
+	switch {
+	case utils.IsFieldRequired(field, markersAccess) || isRequiredWhenEnabled(fieldMarkers):
+		notOptionalCheck.Reportf(pass, field.Pos(), "field %s is gated by feature gate %s and must not be required, as disabling the gate clears it", qualifiedFieldName, gateNames)
+	case !utils.IsFieldOptional(field, markersAccess):
+		notOptionalCheck.Reportf(pass, field.Pos(), "field %s is gated by feature gate %s and must be marked as optional, as disabling the gate clears it", qualifiedFieldName, gateNames)
+	}
+
+	for _, marker := range []string{markers.UnionDiscriminatorMarker, markers.K8sUnionDiscriminatorMarker} {
+		if fieldMarkers.Has(marker) {
+			unionDiscriminatorCheck.Reportf(pass, field.Pos(), "field %s is gated by feature gate %s and should not be used as a union discriminator, marked with %s, as disabling the gate clears it", qualifiedFieldName, gateNames, marker)
+		}
+	}
+
+	if a.knownFeatureGates.Len() == 0 {
+		return
+	}
+
+	for _, gate := range gates {
+		if !a.knownFeatureGates.Has(gate) {
+			unknownGateCheck.Reportf(pass, field.Pos(), "field %s is gated by unknown feature gate %q, must be one of: %s", qualifiedFieldName, gate, strings.Join(sets.List(a.knownFeatureGates), ", "))
+		}
+	}
+}
+
+// checkTypeRules checks that the CEL rules on the struct type do not require any of its gated fields to be set.
+func checkTypeRules(pass *analysis.Pass, typeSpec *ast.TypeSpec, markersAccess markershelper.Markers, jsonTags extractjsontags.StructFieldTags) {
+	sTyp, ok := typeSpec.Type.(*ast.StructType)
+	if !ok || sTyp.Fields == nil {
+		return
+	}
+
+	typeMarkers := markersAccess.TypeMarkers(typeSpec)
+
+	for _, field := range utils.FlattenStructFields(pass, jsonTags, sTyp.Fields).List {
+		gates := featureGates(markersAccess.FieldMarkers(field))
+		if len(gates) == 0 {
+			continue
+		}
+
+		tagInfo := jsonTags.FieldTags(field)
+		if tagInfo.Name == "" || tagInfo.Ignored {
+			continue
+		}
+
+		for _, marker := range typeMarkers.Get(markers.KubebuilderXValidationMarker) {
+			if requiresField(strings.Trim(marker.Arguments["rule"], `"`), tagInfo.Name) {
+				celRequiredCheck.Reportf(pass, typeSpec.Pos(), "type %s has an XValidation rule that requires field %s, which is gated by feature gate %s. Guard the rule with has(self.%s), as disabling the gate clears the field", typeSpec.Name.Name, tagInfo.Name, strings.Join(gates, ", "), tagInfo.Name)
+			}
+		}
+
+		for _, oneOf := range []string{markers.KubebuilderExactlyOneOf, markers.KubebuilderAtLeastOneOfMarker} {
+			for _, marker := range typeMarkers.Get(oneOf) {
+				if slices.Contains(strings.Split(marker.Payload.Value, ";"), tagInfo.Name) {
+					celRequiredCheck.Reportf(pass, typeSpec.Pos(), "type %s has a %s marker that includes field %s, which is gated by feature gate %s. Disabling the gate clears the field, which may leave the object invalid", typeSpec.Name.Name, oneOf, tagInfo.Name, strings.Join(gates, ", "))
+				}
+			}
+		}
+	}
+}
+
+// featureGates returns the names of the feature gates that the field is gated by,
+// from the `+featureGate` marker, and the `+k8s:ifEnabled` and `+k8s:ifDisabled` markers.
+func featureGates(fieldMarkers markershelper.MarkerSet) []string {
+	gates := sets.New[string]()
+
+	for _, marker := range fieldMarkers.Get(markers.FeatureGateMarker) {
+		for _, gate := range strings.Split(marker.Payload.Value, ",") {
+			if gate = strings.TrimSpace(gate); gate != "" {
+				gates.Insert(gate)
+			}
+		}
+	}
+
+	for _, marker := range slices.Concat(fieldMarkers.Get(markers.K8sIfEnabledMarker), fieldMarkers.Get(markers.K8sIfDisabledMarker)) {
+		if gate := marker.Arguments[markershelper.UnnamedArgument]; gate != "" {
+			gates.Insert(gate)
+		}
+	}
+
+	return sets.List(gates)
+}
+
+// isRequiredWhenEnabled determines whether the field is required when its feature gate is enabled,
+// using `+k8s:ifEnabled("Gate")=+k8s:required`.
+func isRequiredWhenEnabled(fieldMarkers markershelper.MarkerSet) bool {
+	for _, marker := range fieldMarkers.Get(markers.K8sIfEnabledMarker) {
+		if marker.Payload.Marker != nil && marker.Payload.Marker.Identifier == markers.K8sRequiredMarker {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requiresField determines whether the CEL rule requires the field to be set.
+// A rule requires the field when `has(self.field)` is one of its top level conditions,
+// or when it refers to the field without checking that it is set, which fails when the field is absent.
+func requiresField(rule, fieldName string) bool {
+	has := "has(self." + fieldName + ")"
+
+	for _, condition := range strings.Split(rule, "&&") {
+		if unwrapParentheses(condition) == has {
+			return true
+		}
+	}
+
+	reference := regexp.MustCompile(`\bself\.` + regexp.QuoteMeta(fieldName) + `\b`)
+
+	return reference.MatchString(rule) && !strings.Contains(rule, has)
+}
+
+// unwrapParentheses removes whitespace, and any parentheses wrapping the whole condition.
+func unwrapParentheses(condition string) string {
+	condition = strings.TrimSpace(condition)
+
+	for strings.HasPrefix(condition, "(") && strings.HasSuffix(condition, ")") {
+		condition = strings.TrimSpace(condition[1 : len(condition)-1])
+	}
+
+	return condition
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package featuregates
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// FeatureGatesConfig contains configuration for the featuregates linter.
+type FeatureGatesConfig struct {
+	// knownFeatureGates is the list of feature gates that fields may be gated by.
+	// When set, fields gated by a feature gate that is not in the list are reported.
+	// When otherwise not specified, the names of feature gates are not checked.
+	KnownFeatureGates []string `json:"knownFeatureGates"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `not-optional`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
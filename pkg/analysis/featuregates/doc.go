@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `featuregates` linter checks that fields added behind feature gates can be safely cleared when their gate is disabled.
+
+Gated fields are identified by the `+featureGate=<Gate>` marker, or by the declarative validation
+`+k8s:ifEnabled("<Gate>")` and `+k8s:ifDisabled("<Gate>")` markers.
+
+When a feature gate is disabled, the API server clears any gated fields from the object.
+Gated fields must therefore be marked as optional, and must not be required,
+either directly or with `+k8s:ifEnabled("<Gate>")=+k8s:required`.
+
+Gated fields must not be used as union discriminators, marked with `+unionDiscriminator` or `+k8s:unionDiscriminator`,
+as clearing the discriminator leaves the union invalid.
+
+XValidation rules on the parent type must not require gated fields to be set.
+A rule requires a field when `has(self.field)` is one of its top level conditions,
+or when it refers to the field without first checking that it is set.
+Gated fields must also not be members of `+kubebuilder:validation:ExactlyOneOf` or `+kubebuilder:validation:AtLeastOneOf` groups.
+
+When `knownFeatureGates` is configured, the linter also reports fields gated by feature gates not in the list.
+*/
+package featuregates
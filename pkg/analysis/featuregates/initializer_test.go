@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package featuregates_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/featuregates"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+)
+
+var _ = Describe("featuregates initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      featuregates.FeatureGatesConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := featuregates.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("featuregates"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid FeatureGatesConfig", testCase{
+				config:      featuregates.FeatureGatesConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid FeatureGatesConfig: KnownFeatureGates", testCase{
+				config: featuregates.FeatureGatesConfig{
+					KnownFeatureGates: []string{"MyGate", "OtherGate"},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid FeatureGatesConfig: KnownFeatureGates: empty name", testCase{
+				config: featuregates.FeatureGatesConfig{
+					KnownFeatureGates: []string{""},
+				},
+				expectedErr: "featuregates.knownFeatureGates[0]: Invalid value: \"\": feature gate name cannot be empty",
+			}),
+			Entry("With an invalid FeatureGatesConfig: KnownFeatureGates: duplicate name", testCase{
+				config: featuregates.FeatureGatesConfig{
+					KnownFeatureGates: []string{"MyGate", "MyGate"},
+				},
+				expectedErr: "featuregates.knownFeatureGates[1]: Duplicate value: \"MyGate\"",
+			}),
+			Entry("With a valid FeatureGatesConfig: Checks: disable unknown-gate", testCase{
+				config: featuregates.FeatureGatesConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown-gate"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid FeatureGatesConfig: Checks: unknown check", testCase{
+				config: featuregates.FeatureGatesConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "featuregates.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: cel-required,not-optional,union-discriminator,unknown-gate",
+			}),
+		)
+	})
+})
@@ -0,0 +1,61 @@
+package a
+
+// +kubebuilder:validation:XValidation:rule="has(self.required) && has(self.gatedRequiredByRule)",message="gatedRequiredByRule must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.gatedGuarded) || self.gatedGuarded.size() > 0",message="gatedGuarded must not be empty"
+// +kubebuilder:validation:XValidation:rule="self.gatedUnguarded.startsWith('a')",message="gatedUnguarded must start with a"
+// +kubebuilder:validation:ExactlyOneOf=gatedOneOf;required
+type FeatureGatesTestStruct struct { // want "type FeatureGatesTestStruct has an XValidation rule that requires field gatedRequiredByRule, which is gated by feature gate MyGate. Guard the rule with has\\(self.gatedRequiredByRule\\), as disabling the gate clears the field" "type FeatureGatesTestStruct has an XValidation rule that requires field gatedUnguarded, which is gated by feature gate MyGate" "type FeatureGatesTestStruct has a kubebuilder:validation:ExactlyOneOf marker that includes field gatedOneOf, which is gated by feature gate MyGate. Disabling the gate clears the field, which may leave the object invalid"
+	// required is not gated.
+	// +required
+	Required string `json:"required"`
+
+	// gated is an optional gated field.
+	// +featureGate=MyGate
+	// +optional
+	Gated string `json:"gated,omitempty"`
+
+	// gatedDeclarative is an optional gated field, using declarative validation.
+	// +k8s:ifEnabled("MyGate")=+k8s:optional
+	// +optional
+	GatedDeclarative string `json:"gatedDeclarative,omitempty"`
+
+	// gatedRequired is a required gated field.
+	// +featureGate=MyGate
+	// +required
+	GatedRequired string `json:"gatedRequired"` // want "field FeatureGatesTestStruct.GatedRequired is gated by feature gate MyGate and must not be required, as disabling the gate clears it"
+
+	// gatedRequiredWhenEnabled is required when the gate is enabled.
+	// +k8s:ifEnabled("OtherGate")=+k8s:required
+	// +optional
+	GatedRequiredWhenEnabled string `json:"gatedRequiredWhenEnabled,omitempty"` // want "field FeatureGatesTestStruct.GatedRequiredWhenEnabled is gated by feature gate OtherGate and must not be required, as disabling the gate clears it"
+
+	// gatedUnmarked is a gated field that is not marked as optional.
+	// +k8s:ifDisabled("MyGate")=+k8s:forbidden
+	GatedUnmarked string `json:"gatedUnmarked,omitempty"` // want "field FeatureGatesTestStruct.GatedUnmarked is gated by feature gate MyGate and must be marked as optional, as disabling the gate clears it"
+
+	// gatedDiscriminator is a gated union discriminator.
+	// +featureGate=MyGate
+	// +unionDiscriminator
+	// +optional
+	GatedDiscriminator string `json:"gatedDiscriminator,omitempty"` // want "field FeatureGatesTestStruct.GatedDiscriminator is gated by feature gate MyGate and should not be used as a union discriminator, marked with unionDiscriminator, as disabling the gate clears it"
+
+	// gatedRequiredByRule is required by a CEL rule on the struct.
+	// +featureGate=MyGate
+	// +optional
+	GatedRequiredByRule string `json:"gatedRequiredByRule,omitempty"`
+
+	// gatedGuarded is guarded by has() in the CEL rule.
+	// +featureGate=MyGate
+	// +optional
+	GatedGuarded string `json:"gatedGuarded,omitempty"`
+
+	// gatedUnguarded is used by a CEL rule without checking that it is set.
+	// +featureGate=MyGate
+	// +optional
+	GatedUnguarded string `json:"gatedUnguarded,omitempty"`
+
+	// gatedOneOf is a member of an ExactlyOneOf group.
+	// +featureGate=MyGate
+	// +optional
+	GatedOneOf string `json:"gatedOneOf,omitempty"`
+}
@@ -0,0 +1,18 @@
+package b
+
+type KnownGatesTestStruct struct {
+	// known is gated by a known feature gate.
+	// +featureGate=KnownGate
+	// +optional
+	Known string `json:"known,omitempty"`
+
+	// unknown is gated by a feature gate that is not known.
+	// +featureGate=UnknownGate
+	// +optional
+	Unknown string `json:"unknown,omitempty"` // want "field KnownGatesTestStruct.Unknown is gated by unknown feature gate \"UnknownGate\", must be one of: KnownGate, OtherKnownGate"
+
+	// unknownDeclarative is gated by a feature gate that is not known, using declarative validation.
+	// +k8s:ifEnabled("UnknownGate")=+k8s:optional
+	// +optional
+	UnknownDeclarative string `json:"unknownDeclarative,omitempty"` // want "field KnownGatesTestStruct.UnknownDeclarative is gated by unknown feature gate \"UnknownGate\""
+}
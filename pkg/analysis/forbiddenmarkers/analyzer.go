@@ -21,15 +21,27 @@ import (
 	"slices"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "forbiddenmarkers"
 
+func init() {
+	checks.DefaultRegistry().Register(Check(name))
+}
+
+// Check returns the check performed by the forbiddenmarkers analyzer on behalf of the named linter.
+// Linters wrapping the forbiddenmarkers analyzer should register this check with the checks registry.
+func Check(linter string) checks.Check {
+	return checks.New(linter, "forbidden-marker", config.SeverityWarning)
+}
+
 type analyzer struct {
 	forbiddenMarkers []Marker
 }
@@ -128,7 +140,7 @@ func markerMatchesAttributeRules(marker markers.Marker, attrRules ...MarkerAttri
 
 func reportField(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string) func(marker markers.Marker) {
 	return func(marker markers.Marker) {
-		pass.Report(analysis.Diagnostic{
+		Check(pass.Analyzer.Name).Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has forbidden marker %q", qualifiedFieldName, marker.String()),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -148,7 +160,7 @@ func reportField(pass *analysis.Pass, field *ast.Field, qualifiedFieldName strin
 
 func reportType(pass *analysis.Pass, typeSpec *ast.TypeSpec) func(marker markers.Marker) {
 	return func(marker markers.Marker) {
-		pass.Report(analysis.Diagnostic{
+		Check(pass.Analyzer.Name).Report(pass, analysis.Diagnostic{
 			Pos:     typeSpec.Pos(),
 			Message: fmt.Sprintf("type %s has forbidden marker %q", typeSpec.Name, marker.String()),
 			SuggestedFixes: []analysis.SuggestedFix{
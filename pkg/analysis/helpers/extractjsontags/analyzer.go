@@ -55,8 +55,14 @@ func (s *structFieldTags) insertFieldTagInfo(field *ast.Field, tagInfo FieldTagI
 }
 
 // FieldTags find the tag information for the named field within the given struct.
+// Fields outside of the package being analyzed, such as the fields of embedded structs
+// from other packages synthesized by utils.FlattenStructFields, have their tags extracted on demand.
 func (s *structFieldTags) FieldTags(field *ast.Field) FieldTagInfo {
-	return s.fieldTags[field]
+	if tagInfo, ok := s.fieldTags[field]; ok || field.Tag == nil {
+		return tagInfo
+	}
+
+	return extractTagInfo(field, field.Tag)
 }
 
 // Analyzer is the analyzer for the jsontags package.
@@ -97,6 +103,13 @@ func run(pass *analysis.Pass) (any, error) {
 	return results, nil
 }
 
+// ExtractFieldTagInfo extracts the json tag information from the given field, in the same way as the Analyzer.
+// This allows the json tags of fields that are not part of the current pass, such as fields of
+// sibling packages parsed from source, to be read.
+func ExtractFieldTagInfo(field *ast.Field) FieldTagInfo {
+	return extractTagInfo(field, field.Tag)
+}
+
 const emptyJSONTagPrefix = `json:"`
 
 //nolint:cyclop
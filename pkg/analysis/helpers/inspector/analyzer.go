@@ -24,9 +24,22 @@ import (
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
-const name = "inspector"
+const (
+	name = "inspector"
+
+	// FieldPathStyleFlag is the name of the analyzer flag that determines how fields
+	// are identified in the qualified field name passed to inspection functions.
+	// Valid values are those of config.FieldPathStyle.
+	FieldPathStyleFlag = "fieldPathStyle"
+)
+
+// fieldPathStyle is the value of the FieldPathStyleFlag.
+//
+//nolint:gochecknoglobals
+var fieldPathStyle = string(config.FieldPathStyleGoName)
 
 // Analyzer is the analyzer for the inspector package.
 // It provides common functionality for analyzers that need to inspect fields and struct.
@@ -36,7 +49,11 @@ var Analyzer = &analysis.Analyzer{
 	Doc:        "Provides common functionality for analyzers that need to inspect fields and struct",
 	Run:        run,
 	Requires:   []*analysis.Analyzer{extractjsontags.Analyzer, markers.Analyzer},
-	ResultType: reflect.TypeOf(newInspector(nil, nil, nil)),
+	ResultType: reflect.TypeOf(newInspector(nil, nil, nil, nil)),
+}
+
+func init() {
+	Analyzer.Flags.StringVar(&fieldPathStyle, FieldPathStyleFlag, fieldPathStyle, "how fields are identified in diagnostics, one of GoName or JSONPath")
 }
 
 func run(pass *analysis.Pass) (any, error) {
@@ -52,5 +69,5 @@ func run(pass *analysis.Pass) (any, error) {
 		return nil, kalerrors.ErrCouldNotGetMarkers
 	}
 
-	return newInspector(astInspector, jsonTags, markersAccess), nil
+	return newInspector(pass, astInspector, jsonTags, markersAccess), nil
 }
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 func TestInspector(t *testing.T) {
@@ -34,6 +35,28 @@ func TestInspector(t *testing.T) {
 	analysistest.Run(t, testdata, testAnalyzer, "a")
 }
 
+func TestFieldPaths(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, fieldPathsTestAnalyzer, "b")
+}
+
+func TestJSONPathFieldPathStyle(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := inspector.Analyzer.Flags.Set(inspector.FieldPathStyleFlag, string(config.FieldPathStyleJSONPath)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := inspector.Analyzer.Flags.Set(inspector.FieldPathStyleFlag, string(config.FieldPathStyleGoName)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	analysistest.Run(t, testdata, qualifiedFieldNameTestAnalyzer, "c")
+}
+
 var errCouldNotGetInspector = errors.New("could not get inspector")
 
 var testAnalyzer = &analysis.Analyzer{
@@ -59,3 +82,45 @@ func run(pass *analysis.Pass) (any, error) {
 
 	return nil, nil //nolint:nilnil
 }
+
+var fieldPathsTestAnalyzer = &analysis.Analyzer{
+	Name:     "testfieldpaths",
+	Doc:      "tests the field paths computed by the inspector analyzer",
+	Run:      runFieldPaths,
+	Requires: []*analysis.Analyzer{inspector.Analyzer},
+}
+
+func runFieldPaths(pass *analysis.Pass) (any, error) {
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, errCouldNotGetInspector
+	}
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, _ markers.Markers, _ string) {
+		for _, path := range inspect.FieldPaths(field) {
+			pass.Reportf(field.Pos(), "%s: %s", path.Root, path.Path)
+		}
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+var qualifiedFieldNameTestAnalyzer = &analysis.Analyzer{
+	Name:     "testqualifiedfieldname",
+	Doc:      "tests the qualified field name passed by the inspector analyzer",
+	Run:      runQualifiedFieldName,
+	Requires: []*analysis.Analyzer{inspector.Analyzer},
+}
+
+func runQualifiedFieldName(pass *analysis.Pass) (any, error) {
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, errCouldNotGetInspector
+	}
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, _ markers.Markers, qualifiedFieldName string) {
+		pass.Reportf(field.Pos(), "field: %s", qualifiedFieldName)
+	})
+
+	return nil, nil //nolint:nilnil
+}
@@ -46,5 +46,29 @@ Example:
 	type Bar interface {
 		Name() string
 	}
+
+The inspector also computes the serialized paths at which each field is reachable from the root objects in the package.
+Root objects are types marked with `+kubebuilder:object:root=true`, or types that embed `TypeMeta`.
+Inline and embedded structs are flattened into their parent, and lists and maps are descended into.
+For example, the `Name` field below is reachable at `spec.containers[*].name` from `Foo`:
+
+	// +kubebuilder:object:root=true
+	type Foo struct {
+		metav1.TypeMeta `json:",inline"`
+		Spec FooSpec `json:"spec"`
+	}
+
+	type FooSpec struct {
+		Containers []Container `json:"containers"`
+	}
+
+	type Container struct {
+		Name string `json:"name"`
+	}
+
+These paths are available through FieldPaths.
+When the analyzer flag `fieldPathStyle` is set to `JSONPath`, the qualified field name passed to the inspection
+functions is each of these paths, prefixed by the root Kind and separated by commas, e.g. `Foo.spec.containers[*].name`,
+rather than the Go struct and field name.
 */
 package inspector
@@ -20,11 +20,16 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"strings"
+	"sync"
 
+	"golang.org/x/tools/go/analysis"
 	astinspector "golang.org/x/tools/go/ast/inspector"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	markersconsts "sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -38,18 +43,27 @@ type Inspector interface {
 
 	// InspectTypeSpec is a function that inspects the type spec and calls the provided inspectTypeSpec function.
 	InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markers.Markers))
+
+	// FieldPaths returns the serialized paths at which the field is reachable from the root objects in the package.
+	// Fields that are not reachable from a root object have no paths.
+	FieldPaths(field *ast.Field) []FieldPath
 }
 
 // inspector implements the Inspector interface.
 type inspector struct {
+	pass      *analysis.Pass
 	inspector *astinspector.Inspector
 	jsonTags  extractjsontags.StructFieldTags
 	markers   markers.Markers
+
+	fieldPathsOnce sync.Once
+	fieldPaths     map[*ast.Field]sets.Set[FieldPath]
 }
 
 // newInspector creates a new inspector.
-func newInspector(astinspector *astinspector.Inspector, jsonTags extractjsontags.StructFieldTags, markers markers.Markers) Inspector {
+func newInspector(pass *analysis.Pass, astinspector *astinspector.Inspector, jsonTags extractjsontags.StructFieldTags, markers markers.Markers) Inspector {
 	return &inspector{
+		pass:      pass,
 		inspector: astinspector,
 		jsonTags:  jsonTags,
 		markers:   markers,
@@ -91,27 +105,47 @@ func (i *inspector) inspectFields(inspectField func(field *ast.Field, jsonTagInf
 			return false
 		}
 
-		var structName string
+		i.processFieldWithRecovery(field, i.qualifiedFieldName(field, stack), inspectField)
 
-		qualifiedFieldName := utils.FieldName(field)
-		if qualifiedFieldName == "" {
-			qualifiedFieldName = types.ExprString(field.Type)
-		}
+		return true
+	})
+}
 
-		// The 0th node in the stack is the *ast.File.
-		file, ok := stack[0].(*ast.File)
-		if ok {
-			structName = utils.GetStructNameFromFile(file, field)
+// qualifiedFieldName returns the name used to identify the field within diagnostics.
+// By default, this is the Go struct name followed by the Go field name.
+// When the JSONPath field path style is configured, the serialized paths from each root object are used instead,
+// each prefixed by the root Kind, e.g. `Foo.spec.bar`, so that fields reachable from several root objects are not ambiguous.
+// This falls back to the Go name when the field is not reachable from a root object.
+func (i *inspector) qualifiedFieldName(field *ast.Field, stack []ast.Node) string {
+	if config.FieldPathStyle(fieldPathStyle) == config.FieldPathStyleJSONPath {
+		if paths := i.FieldPaths(field); len(paths) > 0 {
+			rootPaths := make([]string, 0, len(paths))
+			for _, path := range paths {
+				rootPaths = append(rootPaths, path.Root+"."+path.Path)
+			}
+
+			return strings.Join(rootPaths, ", ")
 		}
+	}
 
-		if structName != "" {
-			qualifiedFieldName = fmt.Sprintf("%s.%s", structName, qualifiedFieldName)
-		}
+	var structName string
 
-		i.processFieldWithRecovery(field, qualifiedFieldName, inspectField)
+	qualifiedFieldName := utils.FieldName(field)
+	if qualifiedFieldName == "" {
+		qualifiedFieldName = types.ExprString(field.Type)
+	}
 
-		return true
-	})
+	// The 0th node in the stack is the *ast.File.
+	file, ok := stack[0].(*ast.File)
+	if ok {
+		structName = utils.GetStructNameFromFile(file, field)
+	}
+
+	if structName != "" {
+		qualifiedFieldName = fmt.Sprintf("%s.%s", structName, qualifiedFieldName)
+	}
+
+	return qualifiedFieldName
 }
 
 // shouldProcessField checks if the field should be processed.
@@ -186,6 +220,16 @@ func (i *inspector) InspectTypeSpec(inspectTypeSpec func(typeSpec *ast.TypeSpec,
 	})
 }
 
+// FieldPaths returns the serialized paths at which the field is reachable from the root objects in the package.
+// The paths are computed once, on first use, and are sorted by root type name and then by path.
+func (i *inspector) FieldPaths(field *ast.Field) []FieldPath {
+	i.fieldPathsOnce.Do(func() {
+		i.fieldPaths = computeFieldPaths(i.pass, i.jsonTags, i.markers)
+	})
+
+	return sortedFieldPaths(i.fieldPaths[field])
+}
+
 func isSchemalessType(markerSet markers.MarkerSet) bool {
 	// Check if the field is marked as schemaless.
 	schemalessMarker := markerSet.Get(markersconsts.KubebuilderSchemaLessMarker)
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package inspector
+
+import (
+	"cmp"
+	"go/ast"
+	"go/token"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+)
+
+// FieldPath is a serialized path at which a field is reachable from a root object.
+type FieldPath struct {
+	// Root is the name of the root type from which the path starts.
+	Root string
+
+	// Path is the serialized path to the field from the root object,
+	// e.g. `spec.template.spec.containers[*].name`.
+	Path string
+}
+
+// fieldPathWalker walks the types reachable from root objects and records the serialized
+// paths at which each field can be found.
+type fieldPathWalker struct {
+	pass     *analysis.Pass
+	jsonTags extractjsontags.StructFieldTags
+	markers  markers.Markers
+
+	paths map[*ast.Field]sets.Set[FieldPath]
+}
+
+// computeFieldPaths walks all root object types within the package and returns, for each reachable field,
+// the set of serialized paths at which the field can be reached.
+// Embedded and inline structs are flattened into their parent, and slices and maps are descended into.
+func computeFieldPaths(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, markersAccess markers.Markers) map[*ast.Field]sets.Set[FieldPath] {
+	w := &fieldPathWalker{
+		pass:     pass,
+		jsonTags: jsonTags,
+		markers:  markersAccess,
+		paths:    make(map[*ast.Field]sets.Set[FieldPath]),
+	}
+
+	if pass == nil {
+		return w.paths
+	}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || !utils.IsRootType(typeSpec, markersAccess.TypeMarkers(typeSpec)) {
+					continue
+				}
+
+				w.walkType(typeSpec.Name.Name, typeSpec.Type, "", sets.New(typeSpec))
+			}
+		}
+	}
+
+	return w.paths
+}
+
+// walkStruct records the paths for each field within the struct, and then descends into the field types.
+func (w *fieldPathWalker) walkStruct(root string, sTyp *ast.StructType, prefix string, visiting sets.Set[*ast.TypeSpec]) {
+	if sTyp.Fields == nil {
+		return
+	}
+
+	for _, field := range sTyp.Fields.List {
+		tagInfo := w.jsonTags.FieldTags(field)
+		if tagInfo.Ignored {
+			continue
+		}
+
+		var path string
+
+		switch {
+		case tagInfo.Inline, len(field.Names) == 0 && tagInfo.Name == "":
+			// Inline and embedded fields are flattened into the parent.
+			w.walkType(root, field.Type, prefix, visiting)
+			continue
+		case tagInfo.Name != "":
+			path = utils.JoinPath(prefix, tagInfo.Name)
+		default:
+			// Without a json name, the field is serialized using its Go name.
+			path = utils.JoinPath(prefix, field.Names[0].Name)
+		}
+
+		if _, ok := w.paths[field]; !ok {
+			w.paths[field] = sets.New[FieldPath]()
+		}
+
+		w.paths[field].Insert(FieldPath{Root: root, Path: path})
+
+		if isSchemalessType(w.markers.FieldMarkers(field)) {
+			// The schema below a schemaless field is not part of the API.
+			continue
+		}
+
+		w.walkType(root, field.Type, path, visiting)
+	}
+}
+
+// walkType descends through the type expression to find any struct types that should be walked.
+// Type specs that are already being visited are skipped to prevent infinite recursion on recursive types.
+func (w *fieldPathWalker) walkType(root string, expr ast.Expr, prefix string, visiting sets.Set[*ast.TypeSpec]) {
+	switch typ := expr.(type) {
+	case *ast.StructType:
+		w.walkStruct(root, typ, prefix, visiting)
+	case *ast.StarExpr:
+		w.walkType(root, typ.X, prefix, visiting)
+	case *ast.ArrayType:
+		w.walkType(root, typ.Elt, prefix+utils.ListElementPathSegment, visiting)
+	case *ast.MapType:
+		w.walkType(root, typ.Value, prefix+utils.MapValuePathSegment, visiting)
+	case *ast.Ident:
+		typeSpec, ok := utils.LookupTypeSpec(w.pass, typ)
+		if !ok || visiting.Has(typeSpec) {
+			return
+		}
+
+		visiting.Insert(typeSpec)
+		defer visiting.Delete(typeSpec)
+
+		w.walkType(root, typeSpec.Type, prefix, visiting)
+	}
+}
+
+// sortedFieldPaths returns the field paths sorted by root, and then by path.
+func sortedFieldPaths(paths sets.Set[FieldPath]) []FieldPath {
+	out := paths.UnsortedList()
+
+	slices.SortFunc(out, func(a, b FieldPath) int {
+		return cmp.Or(cmp.Compare(a.Root, b.Root), cmp.Compare(a.Path, b.Path))
+	})
+
+	return out
+}
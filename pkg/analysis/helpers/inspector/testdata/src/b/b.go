@@ -0,0 +1,77 @@
+package b
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+type Foo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"` // want "Foo: metadata"
+
+	Spec FooSpec `json:"spec"` // want "Foo: spec"
+
+	Status *FooStatus `json:"status,omitempty"` // want "Foo: status"
+}
+
+type FooSpec struct {
+	Template Template `json:"template"` // want "Foo: spec.template"
+
+	Common `json:",inline"`
+
+	Labels map[string]Label `json:"labels,omitempty"` // want "Foo: spec.labels"
+
+	Ignored Template `json:"-"`
+
+	// +kubebuilder:validation:Schemaless
+	Schemaless Template `json:"schemaless"`
+
+	NoTag string // want "Foo: spec.NoTag"
+}
+
+type Template struct {
+	Spec TemplateSpec `json:"spec"` // want "Foo: spec.template.spec" "Bar: template.spec"
+}
+
+type TemplateSpec struct {
+	Containers []Container `json:"containers"` // want "Foo: spec.template.spec.containers" "Bar: template.spec.containers"
+}
+
+type Container struct {
+	Name string `json:"name"` // want "Foo: spec.template.spec.containers\\[\\*\\].name" "Bar: template.spec.containers\\[\\*\\].name"
+
+	Children []Container `json:"children,omitempty"` // want "Foo: spec.template.spec.containers\\[\\*\\].children" "Bar: template.spec.containers\\[\\*\\].children"
+}
+
+type Common struct {
+	Shared string `json:"shared"` // want "Foo: spec.shared"
+}
+
+type Label struct {
+	Value string `json:"value"` // want "Foo: spec.labels.\\*.value"
+}
+
+type FooStatus struct {
+	Phase string `json:"phase"` // want "Foo: status.phase"
+}
+
+// Bar has no root marker, but embeds TypeMeta, as is the case for built-in types.
+type Bar struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Template Template `json:"template"` // want "Bar: template"
+}
+
+// Unreachable is not reachable from a root type so its fields have no paths.
+type Unreachable struct {
+	Field string `json:"field"`
+}
+
+// FooList is a list type and is not a root type.
+// +kubebuilder:object:root=true
+type FooList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Foo `json:"items"`
+}
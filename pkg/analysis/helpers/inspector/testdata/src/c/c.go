@@ -0,0 +1,36 @@
+package c
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+type Foo struct {
+	metav1.TypeMeta `json:",inline"` // want "field: Foo.metav1.TypeMeta"
+
+	Spec FooSpec `json:"spec"` // want "field: Foo.spec"
+}
+
+// Bar shares its spec with Foo, and so the fields of the spec are reachable from both root objects.
+// +kubebuilder:object:root=true
+type Bar struct {
+	metav1.TypeMeta `json:",inline"` // want "field: Bar.metav1.TypeMeta"
+
+	Spec BarSpec `json:"spec"` // want "field: Bar.spec"
+}
+
+type BarSpec struct {
+	Items []Item `json:"items"` // want "field: Bar.spec.items"
+}
+
+type FooSpec struct {
+	Items []Item `json:"items"` // want "field: Foo.spec.items"
+}
+
+type Item struct {
+	Name string `json:"name"` // want "field: Bar.spec.items\\[\\*\\].name, Foo.spec.items\\[\\*\\].name"
+}
+
+type Unreachable struct {
+	Field string `json:"field"` // want "field: Unreachable.Field"
+}
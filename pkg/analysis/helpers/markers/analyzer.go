@@ -133,6 +133,18 @@ func run(pass *analysis.Pass) (any, error) {
 		return nil, kalerrors.ErrCouldNotGetInspector
 	}
 
+	return extractMarkers(inspect, pass.Files, pass.Fset)
+}
+
+// ExtractMarkers extracts the markers from the given files, in the same way as the Analyzer
+// extracts the markers from the files of the package being analyzed.
+// This allows markers to be read from packages that are not part of the current pass,
+// such as sibling packages parsed from source.
+func ExtractMarkers(fset *token.FileSet, files []*ast.File) (Markers, error) {
+	return extractMarkers(inspector.New(files), files, fset)
+}
+
+func extractMarkers(inspect *inspector.Inspector, files []*ast.File, fset *token.FileSet) (Markers, error) {
 	nodeFilter := []ast.Node{
 		// In order to get the godoc comments from a type
 		// definition as such:
@@ -164,7 +176,7 @@ func run(pass *analysis.Pass) (any, error) {
 	// lookups instead of full AST traversals in isDocCommentForField.
 	fieldDocComments := make(map[*ast.CommentGroup]*ast.Field)
 
-	for _, file := range pass.Files {
+	for _, file := range files {
 		ast.Inspect(file, func(n ast.Node) bool {
 			if field, ok := n.(*ast.Field); ok {
 				if field.Doc != nil {
@@ -179,11 +191,11 @@ func run(pass *analysis.Pass) (any, error) {
 	inspect.Preorder(nodeFilter, func(n ast.Node) {
 		switch typ := n.(type) {
 		case *ast.GenDecl:
-			file := findFileForNode(typ, pass.Files)
-			extractGenDeclMarkers(typ, file, pass.Fset, results)
+			file := findFileForNode(typ, files)
+			extractGenDeclMarkers(typ, file, fset, results)
 		case *ast.Field:
-			file := findFileForNode(typ, pass.Files)
-			extractFieldMarkers(typ, file, pass.Fset, results, fieldDocComments)
+			file := findFileForNode(typ, files)
+			extractFieldMarkers(typ, file, fset, results, fieldDocComments)
 		}
 	})
 
@@ -716,7 +728,7 @@ func extractKnownMarkerIDArgumentsAndPayload(id string, marker string) (string,
 	return id, args, payload
 }
 
-var expressionRegex = regexp.MustCompile("\\w*=(?:'[^']*'|\"(\\\\\"|[^\"])*\"|[\\w;\\-\"]+|`[^`]*`)")
+var expressionRegex = regexp.MustCompile("\\w*=(?:'[^']*'|\"(\\\\\"|[^\"])*\"|[\\w;.\\-\"]+|`[^`]*`)")
 
 func extractArgumentsAndPayload(expressionStr string) (map[string]string, Payload) {
 	expressionsMap := map[string]string{}
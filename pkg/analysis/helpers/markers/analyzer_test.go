@@ -17,6 +17,8 @@ package markers
 
 import (
 	"go/ast"
+	"go/parser"
+	"go/token"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -160,6 +162,18 @@ func TestExtractMarker(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "other namespaced kubebuilder-style marker with chained expressions containing paths without quotes",
+			comment: &ast.Comment{Text: `// +custom:marker:specpath=.spec.replicas,statuspath=.status.replicas`},
+			expected: Marker{
+				Type:       MarkerTypeKubebuilder,
+				Identifier: "custom:marker",
+				Arguments: map[string]string{
+					"specpath":   ".spec.replicas",
+					"statuspath": ".status.replicas",
+				},
+			},
+		},
 		{
 			name:    "kubebuilder marker with numeric value",
 			comment: &ast.Comment{Text: `// +kubebuilder:validation:Minimum=10`},
@@ -433,3 +447,38 @@ func TestIdentifierFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractMarkers(t *testing.T) {
+	g := NewWithT(t)
+
+	src := `package a
+
+// +kubebuilder:object:root=true
+type Foo struct {
+	// +optional
+	// +kubebuilder:validation:MaxLength=10
+	Bar string ` + "`json:\"bar\"`" + `
+}
+`
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "a.go", src, parser.ParseComments)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	markers, err := ExtractMarkers(fset, []*ast.File{file})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	typeSpec, ok := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+	g.Expect(ok).To(BeTrue())
+
+	g.Expect(markers.TypeMarkers(typeSpec).Has("kubebuilder:object:root")).To(BeTrue())
+
+	sTyp, ok := typeSpec.Type.(*ast.StructType)
+	g.Expect(ok).To(BeTrue())
+
+	fieldMarkers := markers.FieldMarkers(sTyp.Fields.List[0])
+	g.Expect(fieldMarkers.Has("optional")).To(BeTrue())
+	g.Expect(fieldMarkers.Get("kubebuilder:validation:MaxLength")).To(HaveLen(1))
+	g.Expect(fieldMarkers.Get("kubebuilder:validation:MaxLength")[0].Payload.Value).To(Equal("10"))
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package immutability
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "immutability"
+
+	// defaultMessage is the default message for the XValidation rules of immutable fields.
+	defaultMessage = "{field} is immutable"
+
+	// fieldPlaceholder is replaced with the JSON name of the field within the configured message.
+	fieldPlaceholder = "{field}"
+
+	// immutableRule is the CEL rule that makes a field immutable.
+	immutableRule = "self == oldSelf"
+
+	// noModifyUpdate is the `+k8s:update` payload that prevents a field from being changed once set.
+	noModifyUpdate = "NoModify"
+)
+
+//nolint:gochecknoglobals
+var (
+	unsetTransitionCheck = checks.New(name, "unset-transition", config.SeverityError)
+	messageCheck         = checks.New(name, "message", config.SeverityWarning)
+	markerTypeCheck      = checks.New(name, "marker-type", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(unsetTransitionCheck, messageCheck, markerTypeCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderXValidationMarker,
+		markers.K8sImmutableMarker,
+		markers.K8sUpdateMarker,
+	)
+}
+
+type analyzer struct {
+	preferredMarkerType ImmutabilityMarkerType
+	message             string
+	checks              checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *ImmutabilityConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &ImmutabilityConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		preferredMarkerType: cfg.PreferredMarkerType,
+		message:             cfg.Message,
+		checks:              cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that immutable fields follow a consistent convention, and that immutable optional fields cannot be unset once set.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	typeSpecs := map[string]*ast.TypeSpec{}
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, _ markershelper.Markers) {
+		typeSpecs[typeSpec.Name.Name] = typeSpec
+	})
+
+	inspect.InspectFields(func(field *ast.Field, jsonTagInfo extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		if jsonTagInfo.Name == "" || jsonTagInfo.Ignored || jsonTagInfo.Inline {
+			return
+		}
+
+		a.checkField(pass, typeSpecs[utils.GetStructName(pass, field)], field, markersAccess, jsonTagInfo.Name, qualifiedFieldName)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkField checks the immutability markers of a field.
+// The type spec is the named struct type that declares the field, or nil when the field is declared
+// within an anonymous struct, in which case the rules of its struct are not checked.
+func (a *analyzer) checkField(pass *analysis.Pass, typeSpec *ast.TypeSpec, field *ast.Field, markersAccess markershelper.Markers, jsonName, qualifiedFieldName string) {
+	fieldMarkers := markersAccess.FieldMarkers(field)
+	expectedMessage := strings.ReplaceAll(a.message, fieldPlaceholder, jsonName)
+
+	for _, marker := range fieldMarkers.Get(markers.KubebuilderXValidationMarker) {
+		if !isImmutableRule(marker.Arguments["rule"]) {
+			continue
+		}
+
+		if a.preferredMarkerType == ImmutabilityMarkerTypeDeclarativeValidation {
+			// The marker is replaced, and so its message is not checked.
+			reportMarkerType(pass, field, marker, "// +"+markers.K8sImmutableMarker, qualifiedFieldName)
+		} else {
+			a.checkMessage(pass, field, marker, expectedMessage, qualifiedFieldName)
+		}
+
+		if typeSpec != nil && utils.IsFieldOptional(field, markersAccess) && !hasUnsetTransitionRule(markersAccess.TypeMarkers(typeSpec), jsonName) {
+			unsetTransitionCheck.Reportf(pass, field.Pos(), "field %s is optional and immutable, but its %s rule is not evaluated when the field is added or removed. Type %s should have the XValidation rule \"!has(oldSelf.%s) || has(self.%s)\", so that the field cannot be removed once set", qualifiedFieldName, immutableRule, typeSpec.Name.Name, jsonName, jsonName)
+		}
+	}
+
+	if a.preferredMarkerType != ImmutabilityMarkerTypeKubebuilder {
+		return
+	}
+
+	replacement := fmt.Sprintf("// +%s:rule=%q,message=%q", markers.KubebuilderXValidationMarker, immutableRule, expectedMessage)
+
+	for _, marker := range fieldMarkers.Get(markers.K8sImmutableMarker) {
+		reportMarkerType(pass, field, marker, replacement, qualifiedFieldName)
+	}
+
+	for _, marker := range fieldMarkers.Get(markers.K8sUpdateMarker) {
+		if strings.Contains(marker.Payload.Value, noModifyUpdate) {
+			markerTypeCheck.Reportf(pass, field.Pos(), "field %s should use the %s XValidation rule, instead of %s, to be immutable", qualifiedFieldName, immutableRule, strings.TrimPrefix(marker.RawComment, "// "))
+		}
+	}
+}
+
+// checkMessage checks that the XValidation rule making the field immutable uses the expected message.
+// Rules using a messageExpression are not checked.
+func (a *analyzer) checkMessage(pass *analysis.Pass, field *ast.Field, marker markershelper.Marker, expectedMessage, qualifiedFieldName string) {
+	if _, ok := marker.Arguments["messageExpression"]; ok {
+		return
+	}
+
+	message, ok := marker.Arguments["message"]
+	if ok && strings.Trim(message, `"`) == expectedMessage {
+		return
+	}
+
+	var edit analysis.TextEdit
+
+	if index := strings.Index(marker.RawComment, "message="+message); ok && index >= 0 {
+		edit = analysis.TextEdit{
+			Pos:     marker.Pos + token.Pos(index),
+			End:     marker.Pos + token.Pos(index+len("message="+message)),
+			NewText: []byte("message=" + strconv.Quote(expectedMessage)),
+		}
+	} else {
+		end := marker.Pos + token.Pos(len(strings.TrimRight(marker.RawComment, " \t")))
+		edit = analysis.TextEdit{
+			Pos:     end,
+			End:     end,
+			NewText: []byte(",message=" + strconv.Quote(expectedMessage)),
+		}
+	}
+
+	messageCheck.Report(pass, analysis.Diagnostic{
+		Pos:     field.Pos(),
+		Message: fmt.Sprintf("field %s has an immutable XValidation rule with message %s, should use the message %q", qualifiedFieldName, messageOrNone(message), expectedMessage),
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message:   fmt.Sprintf("use the message %q", expectedMessage),
+				TextEdits: []analysis.TextEdit{edit},
+			},
+		},
+	})
+}
+
+// reportMarkerType reports a marker making the field immutable that is not of the preferred type,
+// with a fix to replace it with the given replacement.
+func reportMarkerType(pass *analysis.Pass, field *ast.Field, marker markershelper.Marker, replacement, qualifiedFieldName string) {
+	markerTypeCheck.Report(pass, analysis.Diagnostic{
+		Pos:     field.Pos(),
+		Message: fmt.Sprintf("field %s should use %s, instead of %s, to be immutable", qualifiedFieldName, strings.TrimPrefix(replacement, "// "), strings.TrimPrefix(marker.RawComment, "// ")),
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf("replace with %s", strings.TrimPrefix(replacement, "// ")),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     marker.Pos,
+						End:     marker.End,
+						NewText: []byte(replacement),
+					},
+				},
+			},
+		},
+	})
+}
+
+// isImmutableRule determines whether the CEL rule requires the field to be unchanged on update.
+func isImmutableRule(rule string) bool {
+	switch removeWhitespace(strings.Trim(rule, `"`)) {
+	case "self==oldSelf", "oldSelf==self":
+		return true
+	default:
+		return false
+	}
+}
+
+// hasUnsetTransitionRule determines whether the type has an XValidation rule
+// that prevents the field from being removed once set.
+// Both `!has(oldSelf.field) || has(self.field)` and `has(self.field) == has(oldSelf.field)` are accepted,
+// either as the whole rule, or as one of its top level conditions.
+func hasUnsetTransitionRule(typeMarkers markershelper.MarkerSet, jsonName string) bool {
+	hasSelf := fmt.Sprintf("has(self.%s)", jsonName)
+	hasOldSelf := fmt.Sprintf("has(oldSelf.%s)", jsonName)
+
+	patterns := []string{
+		"!" + hasOldSelf + "||" + hasSelf,
+		hasSelf + "||!" + hasOldSelf,
+		hasSelf + "==" + hasOldSelf,
+		hasOldSelf + "==" + hasSelf,
+	}
+
+	for _, marker := range typeMarkers.Get(markers.KubebuilderXValidationMarker) {
+		for _, condition := range strings.Split(removeWhitespace(strings.Trim(marker.Arguments["rule"], `"`)), "&&") {
+			for strings.HasPrefix(condition, "(") && strings.HasSuffix(condition, ")") {
+				condition = condition[1 : len(condition)-1]
+			}
+
+			if slices.Contains(patterns, condition) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// removeWhitespace removes all whitespace from the CEL rule, so that rules can be compared regardless of formatting.
+func removeWhitespace(rule string) string {
+	return strings.Join(strings.Fields(rule), "")
+}
+
+// messageOrNone returns the message, or "none" when the rule has no message.
+func messageOrNone(message string) string {
+	if message == "" {
+		return "none"
+	}
+
+	return message
+}
+
+func defaultConfig(cfg *ImmutabilityConfig) {
+	if cfg.Message == "" {
+		cfg.Message = defaultMessage
+	}
+}
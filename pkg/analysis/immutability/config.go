@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package immutability
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// ImmutabilityMarkerType is the style of marker used to declare that a field is immutable.
+type ImmutabilityMarkerType string
+
+const (
+	// ImmutabilityMarkerTypeKubebuilder indicates that immutable fields should use
+	// the `+kubebuilder:validation:XValidation:rule="self == oldSelf"` marker.
+	ImmutabilityMarkerTypeKubebuilder ImmutabilityMarkerType = "Kubebuilder"
+
+	// ImmutabilityMarkerTypeDeclarativeValidation indicates that immutable fields should use
+	// the `+k8s:immutable` marker.
+	ImmutabilityMarkerTypeDeclarativeValidation ImmutabilityMarkerType = "DeclarativeValidation"
+)
+
+// ImmutabilityConfig contains configuration for the immutability linter.
+type ImmutabilityConfig struct {
+	// preferredMarkerType is the style of marker that immutable fields should use.
+	// Valid values are "Kubebuilder" and "DeclarativeValidation".
+	// When set to "Kubebuilder", fields marked with `+k8s:immutable` are reported, with a fix to use
+	// `+kubebuilder:validation:XValidation:rule="self == oldSelf"` instead.
+	// When set to "DeclarativeValidation", fields with a `self == oldSelf` XValidation rule are reported,
+	// with a fix to use `+k8s:immutable` instead.
+	// When otherwise not specified, either style is allowed.
+	PreferredMarkerType ImmutabilityMarkerType `json:"preferredMarkerType"`
+
+	// message is the message that the `self == oldSelf` XValidation rules of immutable fields should use.
+	// The placeholder `{field}` is replaced with the JSON name of the field.
+	// When otherwise not specified, the default value is "{field} is immutable".
+	Message string `json:"message"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `message`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
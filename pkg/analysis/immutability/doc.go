@@ -0,0 +1,33 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `immutability` linter checks that immutable fields follow a consistent convention.
+
+A field is immutable when it has an XValidation rule `self == oldSelf`,
+or when it is marked with the declarative validation `+k8s:immutable` marker, or `+k8s:update=NoModify`.
+
+XValidation rules on a field are transition rules, which are only evaluated when the field is set on both the old and new object.
+An optional field with a `self == oldSelf` rule can therefore still be added, or removed, on update.
+The linter checks that the parent type of immutable optional fields has an XValidation rule `!has(oldSelf.field) || has(self.field)`,
+or `has(self.field) == has(oldSelf.field)`, so that the field cannot be removed once set.
+
+The linter also checks that the `self == oldSelf` rules use a consistent message, by default `<field> is immutable`,
+where `<field>` is the JSON name of the field. A fix is suggested to use the expected message.
+
+When a preferred marker type is configured, the linter reports immutable fields using the other marker type,
+and suggests a fix to convert between the `+kubebuilder:validation:XValidation:rule="self == oldSelf"` and `+k8s:immutable` markers.
+*/
+package immutability
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package immutability_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/immutability"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+)
+
+var _ = Describe("immutability initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      immutability.ImmutabilityConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := immutability.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("immutability"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid ImmutabilityConfig", testCase{
+				config:      immutability.ImmutabilityConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid ImmutabilityConfig: PreferredMarkerType: Kubebuilder", testCase{
+				config: immutability.ImmutabilityConfig{
+					PreferredMarkerType: immutability.ImmutabilityMarkerTypeKubebuilder,
+				},
+				expectedErr: "",
+			}),
+			Entry("With a valid ImmutabilityConfig: PreferredMarkerType: DeclarativeValidation", testCase{
+				config: immutability.ImmutabilityConfig{
+					PreferredMarkerType: immutability.ImmutabilityMarkerTypeDeclarativeValidation,
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid ImmutabilityConfig: PreferredMarkerType: invalid", testCase{
+				config: immutability.ImmutabilityConfig{
+					PreferredMarkerType: "invalid",
+				},
+				expectedErr: "immutability.preferredMarkerType: Invalid value: \"invalid\": invalid value, must be one of \"Kubebuilder\", \"DeclarativeValidation\" or omitted",
+			}),
+			Entry("With a valid ImmutabilityConfig: Checks: disable message", testCase{
+				config: immutability.ImmutabilityConfig{
+					Checks: checks.Config{
+						Disable: []string{"message"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid ImmutabilityConfig: Checks: unknown check", testCase{
+				config: immutability.ImmutabilityConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "immutability.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: marker-type,message,unset-transition",
+			}),
+		)
+	})
+})
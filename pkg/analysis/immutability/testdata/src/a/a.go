@@ -0,0 +1,50 @@
+package a
+
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.optionalGuarded) || has(self.optionalGuarded)",message="optionalGuarded is required once set"
+// +kubebuilder:validation:XValidation:rule="has(self.required) && (has(self.optionalGuardedEquality) == has(oldSelf.optionalGuardedEquality))",message="optionalGuardedEquality cannot be added or removed"
+type ImmutabilityTestStruct struct {
+	// required is an immutable required field.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="required is immutable"
+	// +required
+	Required string `json:"required"`
+
+	// optionalGuarded is an immutable optional field, guarded by a rule on the parent type.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="optionalGuarded is immutable"
+	// +optional
+	OptionalGuarded string `json:"optionalGuarded,omitempty"`
+
+	// optionalGuardedEquality is an immutable optional field, guarded by an equality rule on the parent type.
+	// +kubebuilder:validation:XValidation:rule="oldSelf == self",message="optionalGuardedEquality is immutable"
+	// +optional
+	OptionalGuardedEquality string `json:"optionalGuardedEquality,omitempty"`
+
+	// optionalUnguarded is an immutable optional field, without a rule on the parent type.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="optionalUnguarded is immutable"
+	// +optional
+	OptionalUnguarded string `json:"optionalUnguarded,omitempty"` // want "field ImmutabilityTestStruct.OptionalUnguarded is optional and immutable, but its self == oldSelf rule is not evaluated when the field is added or removed. Type ImmutabilityTestStruct should have the XValidation rule \"!has\\(oldSelf.optionalUnguarded\\) \\|\\| has\\(self.optionalUnguarded\\)\", so that the field cannot be removed once set"
+
+	// inconsistentMessage has an immutable rule with an inconsistent message.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	InconsistentMessage string `json:"inconsistentMessage"` // want "field ImmutabilityTestStruct.InconsistentMessage has an immutable XValidation rule with message \"Value is immutable\", should use the message \"inconsistentMessage is immutable\""
+
+	// missingMessage has an immutable rule without a message.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf"
+	// +required
+	MissingMessage string `json:"missingMessage"` // want "field ImmutabilityTestStruct.MissingMessage has an immutable XValidation rule with message none, should use the message \"missingMessage is immutable\""
+
+	// messageExpression has an immutable rule with a message expression, which is not checked.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",messageExpression="'messageExpression is immutable'"
+	// +required
+	MessageExpression string `json:"messageExpression"`
+
+	// declarative is immutable using declarative validation.
+	// +k8s:immutable
+	// +optional
+	Declarative string `json:"declarative,omitempty"`
+
+	// notImmutable has an XValidation rule that does not make it immutable.
+	// +kubebuilder:validation:XValidation:rule="self.size() > 0",message="notImmutable must not be empty"
+	// +optional
+	NotImmutable string `json:"notImmutable,omitempty"`
+}
@@ -0,0 +1,25 @@
+package b
+
+type DeclarativeValidationTestStruct struct {
+	// kubebuilder is immutable using an XValidation rule.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="kubebuilder is immutable"
+	// +required
+	Kubebuilder string `json:"kubebuilder"` // want "field DeclarativeValidationTestStruct.Kubebuilder should use \\+k8s:immutable, instead of \\+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"kubebuilder is immutable\", to be immutable"
+
+	// declarative is immutable using declarative validation.
+	// +k8s:immutable
+	// +required
+	Declarative string `json:"declarative"`
+}
+
+type DeclarativeValidationMessageTestStruct struct {
+	// wrongMessage is immutable using an XValidation rule with the wrong message.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cannot be changed"
+	// +required
+	WrongMessage string `json:"wrongMessage"` // want "field DeclarativeValidationMessageTestStruct.WrongMessage should use \\+k8s:immutable, instead of \\+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"cannot be changed\", to be immutable"
+
+	// missingMessage is immutable using an XValidation rule without a message.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf"
+	// +required
+	MissingMessage string `json:"missingMessage"` // want "field DeclarativeValidationMessageTestStruct.MissingMessage should use \\+k8s:immutable, instead of \\+kubebuilder:validation:XValidation:rule=\"self == oldSelf\", to be immutable"
+}
@@ -0,0 +1,18 @@
+package c
+
+type KubebuilderTestStruct struct {
+	// kubebuilder is immutable using an XValidation rule.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="kubebuilder is immutable"
+	// +required
+	Kubebuilder string `json:"kubebuilder"`
+
+	// declarative is immutable using declarative validation.
+	// +k8s:immutable
+	// +required
+	Declarative string `json:"declarative"` // want "field KubebuilderTestStruct.Declarative should use \\+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"declarative is immutable\", instead of \\+k8s:immutable, to be immutable"
+
+	// noModify cannot be modified once set using declarative validation.
+	// +k8s:update=NoModify
+	// +required
+	NoModify string `json:"noModify"` // want "field KubebuilderTestStruct.NoModify should use the self == oldSelf XValidation rule, instead of \\+k8s:update=NoModify, to be immutable"
+}
@@ -20,15 +20,27 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "integers"
 
+//nolint:gochecknoglobals
+var (
+	intSizeCheck  = checks.New(name, "int-size", config.SeverityWarning)
+	unsignedCheck = checks.New(name, "unsigned", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(intSizeCheck, unsignedCheck)
+}
+
 // Analyzer is the analyzer for the integers package.
 // It checks that no struct fields or type aliases are `int`, or unsigned integers.
 var Analyzer = &analysis.Analyzer{
@@ -68,8 +80,8 @@ func checkIntegers(pass *analysis.Pass, expr ast.Expr, node ast.Node, prefix str
 	case "int32", "int64":
 		// Valid cases
 	case "int", "int8", "int16":
-		pass.Reportf(node.Pos(), "%s should not use an int, int8 or int16. Use int32 or int64 depending on bounding requirements", prefix)
+		intSizeCheck.Reportf(pass, node.Pos(), "%s should not use an int, int8 or int16. Use int32 or int64 depending on bounding requirements", prefix)
 	case "uint", "uint8", "uint16", "uint32", "uint64":
-		pass.Reportf(node.Pos(), "%s should not use unsigned integers, use only int32 or int64 and apply validation to ensure the value is positive", prefix)
+		unsignedCheck.Reportf(pass, node.Pos(), "%s should not use unsigned integers, use only int32 or int64 and apply validation to ensure the value is positive", prefix)
 	}
 }
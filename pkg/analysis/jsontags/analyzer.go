@@ -18,16 +18,20 @@ package jsontags
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"regexp"
 	"slices"
 	"strings"
 	"unicode"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const (
@@ -35,11 +39,34 @@ const (
 	camelCaseRegex = "^[a-z][a-z0-9]*(?:[A-Z][a-z0-9]*)*$"
 
 	name = "jsontags"
+
+	metav1Path = "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//nolint:gochecknoglobals
+var (
+	missingTagCheck     = checks.New(name, "missing-tag", config.SeverityError)
+	invalidTagCheck     = checks.New(name, "invalid-tag", config.SeverityWarning)
+	fieldNameMatchCheck = checks.New(name, "field-name-match", config.SeverityWarning)
+	duplicateNameCheck  = checks.New(name, "duplicate-name", config.SeverityError)
+
+	// reservedRootNames are the serialized names set by the API server on root objects.
+	reservedRootNames = []string{"apiVersion", "kind", "metadata"}
 )
 
+func init() {
+	checks.DefaultRegistry().Register(
+		missingTagCheck,
+		invalidTagCheck,
+		fieldNameMatchCheck,
+		duplicateNameCheck,
+	)
+}
+
 type analyzer struct {
 	jsonTagRegex   *regexp.Regexp
 	fieldNameMatch FieldNameMatchPolicy
+	checks         checks.Config
 }
 
 // newAnalyzer creates a new analyzer with the given json tag regex.
@@ -58,26 +85,38 @@ func newAnalyzer(cfg *JSONTagsConfig) (*analysis.Analyzer, error) {
 	a := &analyzer{
 		jsonTagRegex:   jsonTagRegex,
 		fieldNameMatch: cfg.FieldNameMatch,
+		checks:         cfg.Checks,
 	}
 
 	return &analysis.Analyzer{
 		Name:     name,
 		Doc:      "Check that all struct fields in an API are tagged with json tags",
 		Run:      a.run,
-		Requires: []*analysis.Analyzer{inspector.Analyzer},
+		Requires: []*analysis.Analyzer{inspector.Analyzer, extractjsontags.Analyzer},
 	}, nil
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
 	}
 
+	jsonTags, ok := pass.ResultOf[extractjsontags.Analyzer].(extractjsontags.StructFieldTags)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetJSONTags
+	}
+
 	inspect.InspectFieldsIncludingListTypes(func(field *ast.Field, jsonTagInfo extractjsontags.FieldTagInfo, _ markers.Markers, qualifiedFieldName string) {
 		a.checkField(pass, field, jsonTagInfo, qualifiedFieldName)
 	})
 
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markers.Markers) {
+		checkDuplicateNames(pass, typeSpec, markersAccess.TypeMarkers(typeSpec), jsonTags)
+	})
+
 	return nil, nil //nolint:nilnil
 }
 
@@ -93,13 +132,13 @@ func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, tagInfo ext
 	prefix = fmt.Sprintf(prefix, qualifiedFieldName)
 
 	if tagInfo.Missing {
-		pass.Reportf(field.Pos(), "%s is missing json tag", prefix)
+		missingTagCheck.Reportf(pass, field.Pos(), "%s is missing json tag", prefix)
 		return
 	}
 
 	if tagInfo.Inline {
 		if !embedded {
-			pass.Reportf(field.Pos(), "%s has inline json tag, but is not embedded", prefix)
+			invalidTagCheck.Reportf(pass, field.Pos(), "%s has inline json tag, but is not embedded", prefix)
 		}
 
 		return
@@ -107,14 +146,14 @@ func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, tagInfo ext
 
 	if tagInfo.Name == "" {
 		if !embedded {
-			pass.Reportf(field.Pos(), "%s has empty json tag", prefix)
+			invalidTagCheck.Reportf(pass, field.Pos(), "%s has empty json tag", prefix)
 		}
 
 		return
 	}
 
 	if !a.jsonTagRegex.MatchString(tagInfo.Name) {
-		pass.Reportf(field.Pos(), "%s json tag does not match pattern %q: %s", prefix, a.jsonTagRegex.String(), tagInfo.Name)
+		invalidTagCheck.Reportf(pass, field.Pos(), "%s json tag does not match pattern %q: %s", prefix, a.jsonTagRegex.String(), tagInfo.Name)
 	}
 
 	a.checkFieldNameMatch(pass, field, tagInfo, prefix)
@@ -137,9 +176,9 @@ func (a *analyzer) checkFieldNameMatch(pass *analysis.Pass, field *ast.Field, ta
 	case FieldNameMatchPolicyIgnore:
 		return
 	case FieldNameMatchPolicyWarn:
-		pass.Reportf(field.Pos(), "%s", message)
+		fieldNameMatchCheck.Reportf(pass, field.Pos(), "%s", message)
 	case FieldNameMatchPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		fieldNameMatchCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: message,
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -160,6 +199,107 @@ func (a *analyzer) checkFieldNameMatch(pass *analysis.Pass, field *ast.Field, ta
 	}
 }
 
+// serializedField is a field that contributes a serialized name to a struct.
+type serializedField struct {
+	// field is the field that declares the serialized name.
+	field *ast.Field
+
+	// member is the field of the struct being checked that contributes the serialized name.
+	// For fields promoted from inline embedded structs, this is the embedded field.
+	member *ast.Field
+}
+
+// checkDuplicateNames checks that no two fields of the struct, including those promoted from
+// inline embedded structs, serialize to the same name.
+// The encoding/json package silently drops one of the fields when two fields share a name.
+// For root objects, it also checks that no fields collide with the names set by the API server.
+func checkDuplicateNames(pass *analysis.Pass, typeSpec *ast.TypeSpec, typeMarkers markers.MarkerSet, jsonTags extractjsontags.StructFieldTags) {
+	sTyp, ok := typeSpec.Type.(*ast.StructType)
+	if !ok || sTyp.Fields == nil {
+		return
+	}
+
+	isRoot := utils.IsRootType(typeSpec, typeMarkers)
+	seen := map[string]serializedField{}
+
+	for _, member := range sTyp.Fields.List {
+		for _, field := range serializedFields(pass, jsonTags, member) {
+			tagInfo := jsonTags.FieldTags(field)
+
+			if isRoot && slices.Contains(reservedRootNames, tagInfo.Name) && !isObjectMetaField(pass, member) {
+				duplicateNameCheck.Reportf(pass, member.Pos(), "%s serializes to %q, which is reserved for root objects", describeField(pass, field, member), tagInfo.Name)
+				continue
+			}
+
+			previous, ok := seen[tagInfo.Name]
+			if !ok {
+				seen[tagInfo.Name] = serializedField{field: field, member: member}
+				continue
+			}
+
+			// Collisions within an embedded struct are reported against the embedded struct itself.
+			if previous.member == member {
+				continue
+			}
+
+			duplicateNameCheck.Reportf(pass, member.Pos(), "%s serializes to %q, which collides with %s", describeField(pass, field, member), tagInfo.Name, describeField(pass, previous.field, previous.member))
+		}
+	}
+}
+
+// serializedFields returns the fields that contribute a serialized name to the struct
+// through the given member, flattening inline embedded structs.
+func serializedFields(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, member *ast.Field) []*ast.Field {
+	fields := utils.FlattenStructFields(pass, jsonTags, &ast.FieldList{List: []*ast.Field{member}})
+
+	return slices.DeleteFunc(fields.List, func(field *ast.Field) bool {
+		tagInfo := jsonTags.FieldTags(field)
+
+		return tagInfo.Missing || tagInfo.Ignored || tagInfo.Inline || tagInfo.Name == ""
+	})
+}
+
+// describeField describes the field for use in a diagnostic, including the embedded field it was promoted through, if any.
+// Fields promoted from embedded structs in other packages are not declared within the package being analyzed,
+// and so are described by their name alone.
+func describeField(pass *analysis.Pass, field, member *ast.Field) string {
+	if field == member {
+		return "field " + utils.GetQualifiedFieldName(pass, field)
+	}
+
+	fieldName := utils.FieldName(field)
+	if structName := utils.GetStructName(pass, field); structName != "" {
+		fieldName = structName + "." + fieldName
+	}
+
+	return fmt.Sprintf("field %s (promoted from embedded field %s)", fieldName, embeddedFieldName(pass, member))
+}
+
+// embeddedFieldName returns the qualified name of the embedded field.
+// Embedded fields of types from other packages are named by their type, e.g. `Foo.metav1.TypeMeta`.
+func embeddedFieldName(pass *analysis.Pass, field *ast.Field) string {
+	_, typ := utils.IsStarExpr(field.Type)
+	if _, ok := typ.(*ast.SelectorExpr); !ok {
+		return utils.GetQualifiedFieldName(pass, field)
+	}
+
+	return fmt.Sprintf("%s.%s", utils.GetStructName(pass, field), types.ExprString(typ))
+}
+
+// isObjectMetaField determines whether the field is one of the metav1 types
+// that provide the reserved fields of root objects.
+func isObjectMetaField(pass *analysis.Pass, field *ast.Field) bool {
+	_, typ := utils.IsStarExpr(field.Type)
+
+	for _, typeName := range []string{"TypeMeta", "ObjectMeta", "ListMeta"} {
+		if utils.IsNamedType(pass, typ, metav1Path, typeName) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func defaultConfig(cfg *JSONTagsConfig) {
 	if cfg.JSONTagRegex == "" {
 		cfg.JSONTagRegex = camelCaseRegex
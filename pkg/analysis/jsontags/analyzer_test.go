@@ -30,7 +30,7 @@ func TestDefaultConfiguration(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	analysistest.Run(t, testdata, a, "a", "c")
+	analysistest.Run(t, testdata, a, "a", "c", "f")
 }
 
 func TestAlternativeRegex(t *testing.T) {
@@ -15,6 +15,8 @@ limitations under the License.
 */
 package jsontags
 
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
 // FieldNameMatchPolicy controls whether json tag names must match the camelCase field name.
 type FieldNameMatchPolicy string
 
@@ -47,4 +49,9 @@ type JSONTagsConfig struct {
 	// When set to "Ignore", this check is disabled.
 	// When otherwise not specified, the default value is "Ignore".
 	FieldNameMatch FieldNameMatchPolicy `json:"fieldNameMatch"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `duplicate-name`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
 }
@@ -32,5 +32,11 @@ The linter can also check that the json tag name matches the camelCase version o
 the Go field name by setting the FieldNameMatch field to "SuggestFix" or "Warn".
 This check uses identifier word-splitting heuristics and can be disabled by
 setting FieldNameMatch to "Ignore".
+
+The linter also checks that no two fields of a struct serialize to the same name.
+Fields promoted from inline embedded structs are included, as the encoding/json package
+silently drops one of the fields when two fields share a name.
+For root objects, fields must not serialize to `apiVersion`, `kind` or `metadata`,
+other than through the `TypeMeta` and `ObjectMeta` types, as these are set by the API server.
 */
 package jsontags
@@ -21,6 +21,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
 )
@@ -68,5 +69,7 @@ func validateConfig(jtc *JSONTagsConfig, fldPath *field.Path) field.ErrorList {
 		))
 	}
 
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(jtc.Checks, name, fldPath.Child("checks"))...)
+
 	return fieldErrors
 }
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/jsontags"
 )
@@ -74,6 +75,22 @@ var _ = Describe("jsontags initializer", func() {
 				},
 				expectedErr: "jsontags.jsonTagRegex: Invalid value: \"^[a-z][a-z0-9]*(?:[A-Z][a-z0-9]*\": invalid regex: error parsing regexp: missing closing ): `^[a-z][a-z0-9]*(?:[A-Z][a-z0-9]*`",
 			}),
+			Entry("With a valid JSONTagsConfig Checks", testCase{
+				config: jsontags.JSONTagsConfig{
+					Checks: checks.Config{
+						Disable: []string{"duplicate-name"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid JSONTagsConfig Checks: unknown check", testCase{
+				config: jsontags.JSONTagsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "jsontags.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: duplicate-name,field-name-match,invalid-tag,missing-tag",
+			}),
 		)
 	})
 })
@@ -0,0 +1,19 @@
+package common
+
+// TypeMeta is not the metav1 TypeMeta, and so does not provide the reserved fields of root objects.
+type TypeMeta struct {
+	APIVersion string `json:"apiVersion"`
+}
+
+type SharedFields struct {
+	Name string `json:"name"`
+
+	// Unexported fields are not serialized.
+	labels map[string]string
+
+	*NestedSharedFields `json:",inline"`
+}
+
+type NestedSharedFields struct {
+	Labels map[string]string `json:"labels"`
+}
@@ -0,0 +1,98 @@
+package f
+
+import (
+	"f/common"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type DuplicateNames struct {
+	Name string `json:"name"`
+
+	DisplayName string `json:"name"` // want "field DuplicateNames.DisplayName serializes to \"name\", which collides with field DuplicateNames.Name"
+
+	Ignored string `json:"-"`
+
+	AlsoIgnored string `json:"-"`
+
+	CommonFields `json:",inline"` // want "field CommonFields.Name \\(promoted from embedded field DuplicateNames.CommonFields\\) serializes to \"name\", which collides with field DuplicateNames.Name"
+}
+
+type CommonFields struct {
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	NestedFields `json:",inline"` // want "field NestedFields.Description \\(promoted from embedded field CommonFields.NestedFields\\) serializes to \"description\", which collides with field CommonFields.Description"
+}
+
+type NestedFields struct {
+	Labels map[string]string `json:"labels"`
+
+	// Description is only reported against CommonFields, and not against the types embedding it.
+	Description string `json:"description"`
+}
+
+type NestedCollision struct {
+	Labels map[string]string `json:"labels,omitempty"`
+
+	CommonFields `json:",inline"` // want "field NestedFields.Labels \\(promoted from embedded field NestedCollision.CommonFields\\) serializes to \"labels\", which collides with field NestedCollision.Labels"
+}
+
+type EmbeddedCollision struct {
+	CommonFields `json:",inline"`
+
+	OtherFields `json:",inline"` // want "field OtherFields.Description \\(promoted from embedded field EmbeddedCollision.OtherFields\\) serializes to \"description\", which collides with field CommonFields.Description \\(promoted from embedded field EmbeddedCollision.CommonFields\\)"
+}
+
+type OtherFields struct {
+	Description string `json:"description"`
+}
+
+type PointerCollision struct {
+	*OtherFields `json:",inline"`
+
+	Description string `json:"description"` // want "field PointerCollision.Description serializes to \"description\", which collides with field OtherFields.Description \\(promoted from embedded field PointerCollision.OtherFields\\)"
+}
+
+type CrossPackageCollision struct {
+	Name string `json:"name"`
+
+	common.SharedFields `json:",inline"` // want "field Name \\(promoted from embedded field CrossPackageCollision.common.SharedFields\\) serializes to \"name\", which collides with field CrossPackageCollision.Name"
+}
+
+type CrossPackageNestedCollision struct {
+	Labels map[string]string `json:"labels,omitempty"`
+
+	*common.SharedFields `json:",inline"` // want "field Labels \\(promoted from embedded field CrossPackageNestedCollision.common.SharedFields\\) serializes to \"labels\", which collides with field CrossPackageNestedCollision.Labels"
+}
+
+// +kubebuilder:object:root=true
+type RootObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Kind string `json:"kind"` // want "field RootObject.Kind serializes to \"kind\", which is reserved for root objects"
+
+	Spec RootObjectSpec `json:"spec"`
+
+	ResourceFields `json:",inline"` // want "field ResourceFields.APIVersion \\(promoted from embedded field RootObject.ResourceFields\\) serializes to \"apiVersion\", which is reserved for root objects"
+}
+
+// +kubebuilder:object:root=true
+type RootObjectWithOtherTypeMeta struct {
+	common.TypeMeta   `json:",inline"` // want "field APIVersion \\(promoted from embedded field RootObjectWithOtherTypeMeta.common.TypeMeta\\) serializes to \"apiVersion\", which is reserved for root objects"
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+type RootObjectSpec struct {
+	// Kind is allowed outside of the root object.
+	Kind string `json:"kind"`
+
+	// Metadata is allowed outside of the root object.
+	Metadata string `json:"metadata"`
+}
+
+type ResourceFields struct {
+	APIVersion string `json:"apiVersion"`
+}
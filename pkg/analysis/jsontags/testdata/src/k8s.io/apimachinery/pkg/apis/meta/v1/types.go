@@ -3,7 +3,10 @@ This is a copy of the minimum amount of the original file to be able to test the
 */
 package v1
 
-type TypeMeta struct{}
+type TypeMeta struct {
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
 
 type ObjectMeta struct{}
 
@@ -20,11 +20,13 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -32,6 +34,16 @@ const (
 	name = "maxlength"
 )
 
+//nolint:gochecknoglobals
+var (
+	maxLengthCheck = checks.New(name, "max-length", config.SeverityWarning)
+	maxItemsCheck  = checks.New(name, "max-items", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(maxLengthCheck, maxItemsCheck)
+}
+
 // Analyzer is the analyzer for the maxlength package.
 // It checks that strings and arrays have maximum lengths and maximum items respectively.
 var Analyzer = &analysis.Analyzer{
@@ -83,7 +95,7 @@ func checkString(pass *analysis.Pass, ident *ast.Ident, node ast.Node, aliases [
 	markers := getCombinedMarkers(markersAccess, node, aliases)
 
 	if needsMaxLength(markers) {
-		pass.Reportf(node.Pos(), "%s must have a maximum length, add %s marker", prefix, marker)
+		maxLengthCheck.Reportf(pass, node.Pos(), "%s must have a maximum length, add %s marker", prefix, marker)
 	}
 }
 
@@ -131,7 +143,7 @@ func checkArrayType(pass *analysis.Pass, arrayType *ast.ArrayType, node ast.Node
 	markerSet := getCombinedMarkers(markersAccess, node, aliases)
 
 	if !markerSet.Has(markers.KubebuilderMaxItemsMarker) {
-		pass.Reportf(node.Pos(), "%s must have a maximum items, add %s marker", prefix, markers.KubebuilderMaxItemsMarker)
+		maxItemsCheck.Reportf(pass, node.Pos(), "%s must have a maximum items, add %s marker", prefix, markers.KubebuilderMaxItemsMarker)
 	}
 }
 
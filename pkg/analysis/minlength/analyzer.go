@@ -20,11 +20,13 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -32,6 +34,18 @@ const (
 	name = "minlength"
 )
 
+//nolint:gochecknoglobals
+var (
+	minLengthCheck     = checks.New(name, "min-length", config.SeverityWarning)
+	minItemsCheck      = checks.New(name, "min-items", config.SeverityWarning)
+	minPropertiesCheck = checks.New(name, "min-properties", config.SeverityWarning)
+	invalidMarkerCheck = checks.New(name, "invalid-marker", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(minLengthCheck, minItemsCheck, minPropertiesCheck, invalidMarkerCheck)
+}
+
 // Analyzer is the analyzer for the minlength package.
 // It checks that strings and arrays have minimum lengths and minimum items respectively.
 var Analyzer = &analysis.Analyzer{
@@ -83,7 +97,7 @@ func checkString(pass *analysis.Pass, ident *ast.Ident, node ast.Node, aliases [
 	markers := getCombinedMarkers(markersAccess, node, aliases)
 
 	if needsMinLength(markers) {
-		pass.Reportf(node.Pos(), "%s must have a minimum length, add %s marker", prefix, marker)
+		minLengthCheck.Reportf(pass, node.Pos(), "%s must have a minimum length, add %s marker", prefix, marker)
 	}
 }
 
@@ -135,7 +149,7 @@ func checkArrayType(pass *analysis.Pass, arrayType *ast.ArrayType, node ast.Node
 	markerSet := getCombinedMarkers(markersAccess, node, aliases)
 
 	if !markerSet.Has(markers.KubebuilderMinItemsMarker) {
-		pass.Reportf(node.Pos(), "%s must have a minimum items, add %s marker", prefix, markers.KubebuilderMinItemsMarker)
+		minItemsCheck.Reportf(pass, node.Pos(), "%s must have a minimum items, add %s marker", prefix, markers.KubebuilderMinItemsMarker)
 	}
 }
 
@@ -162,7 +176,7 @@ func checkMapType(pass *analysis.Pass, node ast.Node, aliases []*ast.TypeSpec, m
 	markerSet := getCombinedMarkers(markersAccess, node, aliases)
 
 	if !markerSet.Has(markers.KubebuilderMinPropertiesMarker) {
-		pass.Reportf(node.Pos(), "%s must have a minimum properties, add %s marker", prefix, markers.KubebuilderMinPropertiesMarker)
+		minPropertiesCheck.Reportf(pass, node.Pos(), "%s must have a minimum properties, add %s marker", prefix, markers.KubebuilderMinPropertiesMarker)
 	}
 }
 
@@ -171,7 +185,7 @@ func checkStructType(pass *analysis.Pass, structType *ast.StructType, node ast.N
 
 	minProperties, err := utils.GetMinProperties(markerSet)
 	if err != nil {
-		pass.Reportf(node.Pos(), "could not get min properties for struct: %v", err)
+		invalidMarkerCheck.Reportf(pass, node.Pos(), "could not get min properties for struct: %v", err)
 		return
 	}
 
@@ -196,7 +210,7 @@ func checkStructType(pass *analysis.Pass, structType *ast.StructType, node ast.N
 	}
 
 	// The field does not have a min properties, and does not have any required fields.
-	pass.Reportf(node.Pos(), "%s must have either a required field or a minimum properties, add %s marker", prefix, markers.KubebuilderMinPropertiesMarker)
+	minPropertiesCheck.Reportf(pass, node.Pos(), "%s must have either a required field or a minimum properties, add %s marker", prefix, markers.KubebuilderMinPropertiesMarker)
 }
 
 func getCombinedMarkers(markersAccess markershelper.Markers, node ast.Node, aliases []*ast.TypeSpec) markershelper.MarkerSet {
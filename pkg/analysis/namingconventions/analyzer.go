@@ -23,15 +23,27 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "namingconventions"
 
+func init() {
+	checks.DefaultRegistry().Register(Check(name))
+}
+
+// Check returns the check performed by the namingconventions analyzer on behalf of the named linter.
+// Linters wrapping the namingconventions analyzer should register this check with the checks registry.
+func Check(linter string) checks.Check {
+	return checks.New(linter, "convention", config.SeverityWarning)
+}
+
 type analyzer struct {
 	conventions []Convention
 }
@@ -100,7 +112,7 @@ func checkField(pass *analysis.Pass, field *ast.Field, tagInfo extractjsontags.F
 }
 
 func reportConventionWithSuggestedFixes(pass *analysis.Pass, field *ast.Field, convention Convention, qualifiedFieldName string, suggestedFixes ...analysis.SuggestedFix) {
-	pass.Report(analysis.Diagnostic{
+	Check(pass.Analyzer.Name).Report(pass, analysis.Diagnostic{
 		Pos:            field.Pos(),
 		Message:        fmt.Sprintf("naming convention %q: field %s: %s", convention.Name, qualifiedFieldName, convention.Message),
 		SuggestedFixes: suggestedFixes,
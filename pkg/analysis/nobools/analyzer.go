@@ -20,15 +20,24 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "nobools"
 
+//nolint:gochecknoglobals
+var boolCheck = checks.New(name, "bool", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(boolCheck)
+}
+
 // Analyzer is the analyzer for the nobools package.
 // It checks that no struct fields are `bool`.
 var Analyzer = &analysis.Analyzer{
@@ -64,6 +73,6 @@ func checkBool(pass *analysis.Pass, expr ast.Expr, node ast.Node, prefix string)
 	}
 
 	if ident.Name == "bool" {
-		pass.Reportf(node.Pos(), "%s should not use a bool. Use a string type with meaningful constant values as an enum.", prefix)
+		boolCheck.Reportf(pass, node.Pos(), "%s should not use a bool. Use a string type with meaningful constant values as an enum.", prefix)
 	}
 }
@@ -20,15 +20,24 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "nodurations"
 
+//nolint:gochecknoglobals
+var durationCheck = checks.New(name, "duration", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(durationCheck)
+}
+
 // Analyzer is the analyzer for the nodurations package.
 // It checks that no struct field is of a type either time.Duration or metav1.Duration.
 var Analyzer = &analysis.Analyzer{
@@ -80,5 +89,5 @@ func isDurationType(pass *analysis.Pass, expr ast.Expr) bool {
 }
 
 func checkDuration(pass *analysis.Pass, expr ast.Expr, node ast.Node, prefix string) {
-	pass.Reportf(node.Pos(), "%s should not use a Duration. Use an integer type with units in the name to avoid the need for clients to implement Go style duration parsing.", prefix)
+	durationCheck.Reportf(pass, node.Pos(), "%s should not use a Duration. Use an integer type with units in the name to avoid the need for clients to implement Go style duration parsing.", prefix)
 }
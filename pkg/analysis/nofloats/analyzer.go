@@ -20,15 +20,24 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "nofloats"
 
+//nolint:gochecknoglobals
+var floatCheck = checks.New(name, "float", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(floatCheck)
+}
+
 // Analyzer is the analyzer for the nofloats package.
 // It checks that no struct fields are `float`.
 var Analyzer = &analysis.Analyzer{
@@ -64,6 +73,6 @@ func checkFloat(pass *analysis.Pass, expr ast.Expr, node ast.Node, prefix string
 	}
 
 	if ident.Name == "float32" || ident.Name == "float64" {
-		pass.Reportf(node.Pos(), "%s should not use a float value because they cannot be reliably round-tripped.", prefix)
+		floatCheck.Reportf(pass, node.Pos(), "%s should not use a float value because they cannot be reliably round-tripped.", prefix)
 	}
 }
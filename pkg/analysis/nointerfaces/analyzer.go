@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package nointerfaces
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const name = "nointerfaces"
+
+//nolint:gochecknoglobals
+var (
+	unstructuredTypeCheck      = checks.New(name, "unstructured-type", config.SeverityWarning)
+	missingReasonCheck         = checks.New(name, "missing-reason", config.SeverityWarning)
+	preserveUnknownFieldsCheck = checks.New(name, "preserve-unknown-fields", config.SeverityWarning)
+
+	// unstructuredTypes are the named types, by package path, that hold arbitrary payloads.
+	unstructuredTypes = map[string][]string{
+		"encoding/json":                                            {"RawMessage"},
+		"k8s.io/apimachinery/pkg/runtime":                          {"RawExtension"},
+		"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1": {"JSON"},
+	}
+)
+
+func init() {
+	checks.DefaultRegistry().Register(unstructuredTypeCheck, missingReasonCheck, preserveUnknownFieldsCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderSchemaLessMarker,
+		markers.KubebuilderPreserveUnknownFieldsMarker,
+		markers.KubebuilderEmbeddedResourceMarker,
+	)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *NoInterfacesConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &NoInterfacesConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that interfaces and unstructured payloads, which escape schema validation, are not used unless explicitly marked schemaless with a documented reason.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	// The schema of fields within schemaless types is not generated,
+	// so these fields may use unstructured types freely.
+	schemalessTypes := sets.New[string]()
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		typeMarkers := markersAccess.TypeMarkers(typeSpec)
+
+		if isSchemaless(typeMarkers) {
+			schemalessTypes.Insert(typeSpec.Name.Name)
+		} else {
+			utils.NewTypeChecker(isUnstructuredType, reportUnstructuredType).CheckNode(pass, typeSpec)
+		}
+
+		checkTypeSpecMarkers(pass, typeSpec, typeMarkers)
+		checkSchemalessFields(pass, typeSpec, markersAccess)
+	})
+
+	// Fields marked as schemaless are not inspected, so only need to be exempted
+	// when they preserve unknown fields.
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, _ string) {
+		fieldMarkers := utils.TypeAwareMarkerCollectionForField(pass, markersAccess, field)
+		if isSchemaless(fieldMarkers) || schemalessTypes.Has(utils.GetStructName(pass, field)) {
+			return
+		}
+
+		utils.NewTypeChecker(isUnstructuredType, reportUnstructuredType).CheckNode(pass, field)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+func reportUnstructuredType(pass *analysis.Pass, expr ast.Expr, node ast.Node, prefix string) {
+	unstructuredTypeCheck.Reportf(pass, node.Pos(), "%s should not use %s, which escapes schema validation. Use a structured type, or mark it with %s or %s and document why", prefix, types.ExprString(expr), markers.KubebuilderSchemaLessMarker, markers.KubebuilderPreserveUnknownFieldsMarker)
+}
+
+// checkTypeSpecMarkers checks that types marked as schemaless document why,
+// and that types preserving unknown fields are embedded resources.
+func checkTypeSpecMarkers(pass *analysis.Pass, typeSpec *ast.TypeSpec, typeMarkers markershelper.MarkerSet) {
+	if !isSchemaless(typeMarkers) {
+		return
+	}
+
+	if !hasReason(typeDoc(pass, typeSpec)) {
+		missingReasonCheck.Reportf(pass, typeSpec.Pos(), "type %s is marked %s but does not document why its schema cannot be described", typeSpec.Name.Name, schemalessMarker(typeMarkers))
+	}
+
+	if typeMarkers.Has(markers.KubebuilderPreserveUnknownFieldsMarker) && !typeMarkers.Has(markers.KubebuilderEmbeddedResourceMarker) {
+		preserveUnknownFieldsCheck.Reportf(pass, typeSpec.Pos(), "type %s is marked %s but is not an embedded resource. Unknown fields should only be preserved for types marked %s", typeSpec.Name.Name, markers.KubebuilderPreserveUnknownFieldsMarker, markers.KubebuilderEmbeddedResourceMarker)
+	}
+}
+
+// checkSchemalessFields checks that the fields of the struct that are marked as schemaless document why.
+// The fields are visited directly, as the inspector skips fields marked as schemaless.
+func checkSchemalessFields(pass *analysis.Pass, typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+	sTyp, ok := typeSpec.Type.(*ast.StructType)
+	if !ok || sTyp.Fields == nil {
+		return
+	}
+
+	for _, field := range sTyp.Fields.List {
+		fieldMarkers := markersAccess.FieldMarkers(field)
+		if !isSchemaless(fieldMarkers) || hasReason(field.Doc) {
+			continue
+		}
+
+		missingReasonCheck.Reportf(pass, field.Pos(), "field %s is marked %s but does not document why its schema cannot be described", utils.GetQualifiedFieldName(pass, field), schemalessMarker(fieldMarkers))
+	}
+}
+
+// isUnstructuredType determines whether the expression is an interface, or a type that holds an arbitrary payload.
+func isUnstructuredType(pass *analysis.Pass, expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.InterfaceType:
+		return true
+	case *ast.Ident:
+		obj, ok := pass.TypesInfo.Uses[e].(*types.TypeName)
+		return ok && obj.Pkg() == nil && obj.Name() == "any"
+	case *ast.SelectorExpr:
+		obj, ok := pass.TypesInfo.Uses[e.Sel].(*types.TypeName)
+		if !ok || obj.Pkg() == nil {
+			return false
+		}
+
+		for _, typeName := range unstructuredTypes[obj.Pkg().Path()] {
+			if obj.Name() == typeName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isSchemaless determines whether the markers exempt the type or field from schema validation.
+func isSchemaless(markerSet markershelper.MarkerSet) bool {
+	return schemalessMarker(markerSet) != ""
+}
+
+// schemalessMarker returns the marker that exempts the type or field from schema validation, if any.
+func schemalessMarker(markerSet markershelper.MarkerSet) string {
+	switch {
+	case markerSet.Has(markers.KubebuilderSchemaLessMarker):
+		return markers.KubebuilderSchemaLessMarker
+	case markerSet.Has(markers.KubebuilderPreserveUnknownFieldsMarker):
+		return markers.KubebuilderPreserveUnknownFieldsMarker
+	default:
+		return ""
+	}
+}
+
+// hasReason determines whether the comment contains any text other than markers.
+func hasReason(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+
+	for _, comment := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+		if text != "" && !strings.HasPrefix(text, "+") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// typeDoc returns the documentation for the type spec.
+// Where the type is declared alone, the documentation is attached to the declaration.
+func typeDoc(pass *analysis.Pass, typeSpec *ast.TypeSpec) *ast.CommentGroup {
+	if typeSpec.Doc != nil {
+		return typeSpec.Doc
+	}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE || len(genDecl.Specs) != 1 {
+				continue
+			}
+
+			if genDecl.Specs[0] == typeSpec {
+				return genDecl.Doc
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `nointerfaces` linter checks that fields in the API types do not use types that escape schema validation.
+
+Interfaces (`interface{}` and `any`), `json.RawMessage`, `runtime.RawExtension` and `apiextensionsv1.JSON`
+can hold arbitrary payloads, and so cannot be described by the OpenAPI schema generated for the API.
+The linter reports fields, and types, using these types, unless they are marked with either
+`+kubebuilder:validation:Schemaless` or `+kubebuilder:pruning:PreserveUnknownFields`.
+Fields within types carrying either of these markers are not reported.
+
+Fields and types carrying either of these markers must also document why their schema cannot be described,
+with at least one line of comment that is not a marker.
+
+The `+kubebuilder:pruning:PreserveUnknownFields` marker on a type should be reserved for embedded resources,
+and so the linter reports types carrying the marker that are not also marked with `+kubebuilder:validation:EmbeddedResource`.
+*/
+package nointerfaces
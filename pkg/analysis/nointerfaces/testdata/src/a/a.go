@@ -0,0 +1,71 @@
+package a
+
+import (
+	"encoding/json"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type NoInterfacesTestStruct struct {
+	// Name is a structured field.
+	Name string `json:"name"`
+
+	Interface interface{} `json:"interface"` // want "field NoInterfacesTestStruct.Interface should not use interface\\{\\}, which escapes schema validation. Use a structured type, or mark it with kubebuilder:validation:Schemaless or kubebuilder:pruning:PreserveUnknownFields and document why"
+
+	Any any `json:"any"` // want "field NoInterfacesTestStruct.Any should not use any, which escapes schema validation"
+
+	AnyPtr *any `json:"anyPtr"` // want "field NoInterfacesTestStruct.AnyPtr pointer should not use any, which escapes schema validation"
+
+	AnyMap map[string]any `json:"anyMap"` // want "field NoInterfacesTestStruct.AnyMap map value should not use any, which escapes schema validation"
+
+	RawMessage json.RawMessage `json:"rawMessage"` // want "field NoInterfacesTestStruct.RawMessage should not use json.RawMessage, which escapes schema validation"
+
+	RawExtension runtime.RawExtension `json:"rawExtension"` // want "field NoInterfacesTestStruct.RawExtension should not use runtime.RawExtension, which escapes schema validation"
+
+	RawExtensions []runtime.RawExtension `json:"rawExtensions"` // want "field NoInterfacesTestStruct.RawExtensions array element should not use runtime.RawExtension, which escapes schema validation"
+
+	JSON *apiextensionsv1.JSON `json:"json"` // want "field NoInterfacesTestStruct.JSON pointer should not use apiextensionsv1.JSON, which escapes schema validation"
+
+	Alias AnyAlias `json:"alias"` // want "field NoInterfacesTestStruct.Alias type AnyAlias should not use any, which escapes schema validation"
+
+	// Payload is passed through to the plugin unmodified, and is validated by the plugin itself.
+	// +kubebuilder:validation:Schemaless
+	Payload runtime.RawExtension `json:"payload"`
+
+	// Config is opaque to the controller, and is validated by the plugin itself.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Config apiextensionsv1.JSON `json:"config"`
+
+	// +kubebuilder:validation:Schemaless
+	Undocumented json.RawMessage `json:"undocumented"` // want "field NoInterfacesTestStruct.Undocumented is marked kubebuilder:validation:Schemaless but does not document why its schema cannot be described"
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UndocumentedPreserved runtime.RawExtension `json:"undocumentedPreserved"` // want "field NoInterfacesTestStruct.UndocumentedPreserved is marked kubebuilder:pruning:PreserveUnknownFields but does not document why its schema cannot be described"
+
+	Embedded EmbeddedObject `json:"embedded"`
+
+	Unjustified UnjustifiedObject `json:"unjustified"`
+
+	Opaque OpaqueObject `json:"opaque"`
+}
+
+type AnyAlias any // want "type AnyAlias should not use any, which escapes schema validation"
+
+// EmbeddedObject holds a complete Kubernetes object, which is validated on creation.
+// +kubebuilder:validation:EmbeddedResource
+// +kubebuilder:pruning:PreserveUnknownFields
+type EmbeddedObject struct {
+	runtime.RawExtension `json:",inline"`
+}
+
+// UnjustifiedObject holds arbitrary content.
+// +kubebuilder:pruning:PreserveUnknownFields
+type UnjustifiedObject struct { // want "type UnjustifiedObject is marked kubebuilder:pruning:PreserveUnknownFields but is not an embedded resource. Unknown fields should only be preserved for types marked kubebuilder:validation:EmbeddedResource"
+	Data map[string]string `json:"data"`
+}
+
+// +kubebuilder:validation:Schemaless
+type OpaqueObject struct { // want "type OpaqueObject is marked kubebuilder:validation:Schemaless but does not document why its schema cannot be described"
+	Data json.RawMessage `json:"data"`
+}
@@ -0,0 +1,7 @@
+// This is a copy of the minimum amount of the original file to be able to test the nointerfaces linter.
+package v1
+
+// JSON represents any valid JSON value.
+type JSON struct {
+	Raw []byte `json:"-"`
+}
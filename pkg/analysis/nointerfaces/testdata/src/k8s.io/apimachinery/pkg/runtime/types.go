@@ -0,0 +1,7 @@
+// This is a copy of the minimum amount of the original file to be able to test the nointerfaces linter.
+package runtime
+
+// RawExtension is used to hold extensions in external versions.
+type RawExtension struct {
+	Raw []byte `json:"-"`
+}
@@ -21,17 +21,26 @@ import (
 	"go/token"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const (
 	name = "nomaps"
 )
 
+//nolint:gochecknoglobals
+var mapCheck = checks.New(name, "map", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(mapCheck)
+}
+
 type analyzer struct {
 	policy NoMapsPolicy
 }
@@ -110,7 +119,7 @@ func isBasicMap(pass *analysis.Pass, mapType *ast.MapType) bool {
 }
 
 func report(pass *analysis.Pass, pos token.Pos, fieldName string) {
-	pass.Report(analysis.Diagnostic{
+	mapCheck.Report(pass, analysis.Diagnostic{
 		Pos:     pos,
 		Message: fmt.Sprintf("%s should not use a map type, use a list type with a unique name/identifier instead", fieldName),
 	})
@@ -20,16 +20,28 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
 const name = "nonpointerstructs"
 
+//nolint:gochecknoglobals
+var (
+	shouldBeRequiredCheck = checks.New(name, "should-be-required", config.SeverityWarning)
+	shouldBeOptionalCheck = checks.New(name, "should-be-optional", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(shouldBeRequiredCheck, shouldBeOptionalCheck)
+}
+
 func newAnalyzer(cfg *Config) *analysis.Analyzer {
 	if cfg == nil {
 		cfg = &Config{}
@@ -40,6 +52,7 @@ func newAnalyzer(cfg *Config) *analysis.Analyzer {
 	a := &analyzer{
 		preferredRequiredMarker: cfg.PreferredRequiredMarker,
 		preferredOptionalMarker: cfg.PreferredOptionalMarker,
+		checks:                  cfg.Checks,
 	}
 
 	return &analysis.Analyzer{
@@ -53,9 +66,12 @@ func newAnalyzer(cfg *Config) *analysis.Analyzer {
 type analyzer struct {
 	preferredRequiredMarker string
 	preferredOptionalMarker string
+	checks                  checks.Config
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -161,7 +177,7 @@ func (a *analyzer) handleShouldBeRequired(pass *analysis.Pass, field *ast.Field,
 		NewText: fmt.Appendf(nil, "// +%s\n", a.preferredRequiredMarker),
 	})
 
-	pass.Report(analysis.Diagnostic{
+	shouldBeRequiredCheck.Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		End:     field.Pos(),
 		Message: fmt.Sprintf("field %s is a non-pointer struct with required fields. It must be marked as required.", qualifiedFieldName),
@@ -195,7 +211,7 @@ func (a *analyzer) handleShouldBeOptional(pass *analysis.Pass, field *ast.Field,
 		NewText: fmt.Appendf(nil, "// +%s\n", a.preferredOptionalMarker),
 	})
 
-	pass.Report(analysis.Diagnostic{
+	shouldBeOptionalCheck.Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		Message: fmt.Sprintf("field %s is a non-pointer struct with no required fields. It must be marked as optional.", qualifiedFieldName),
 		SuggestedFixes: []analysis.SuggestedFix{
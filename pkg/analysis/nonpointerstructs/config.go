@@ -15,15 +15,33 @@ limitations under the License.
 */
 package nonpointerstructs
 
+import (
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
 // Config is the configuration for the nonpointerstructs linter.
 type Config struct {
 	// preferredRequiredMarker is the preferred marker to use for required fields when providing fixes.
-	// If this field is not set, the default value is "required".
+	// If this field is not set, it is inherited from `preferences.requiredMarker`, or the default value is "required".
 	// Valid values are "required" and "kubebuilder:validation:Required" and "k8s:required".
 	PreferredRequiredMarker string `json:"preferredRequiredMarker"`
 
 	// preferredOptionalMarker is the preferred marker to use for optional fields when providing fixes.
-	// If this field is not set, the default value is "optional".
+	// If this field is not set, it is inherited from `preferences.optionalMarker`, or the default value is "optional".
 	// Valid values are "optional" and "kubebuilder:validation:Optional" and "k8s:optional".
 	PreferredOptionalMarker string `json:"preferredOptionalMarker"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `should-be-optional`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
+
+// MarkerPreferences returns the settings that may be inherited from the shared preferences.
+func (c *Config) MarkerPreferences() []config.MarkerPreference {
+	return []config.MarkerPreference{
+		{Kind: config.MarkerKindRequired, Field: "preferredRequiredMarker", Value: &c.PreferredRequiredMarker},
+		{Kind: config.MarkerKindOptional, Field: "preferredOptionalMarker", Value: &c.PreferredOptionalMarker},
+	}
 }
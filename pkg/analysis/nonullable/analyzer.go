@@ -21,6 +21,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/forbiddenmarkers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 )
@@ -30,6 +31,10 @@ const (
 	doc  = "Check that nullable marker is not present on any types or fields."
 )
 
+func init() {
+	checks.DefaultRegistry().Register(forbiddenmarkers.Check(name))
+}
+
 var errUnexpectedInitializerType = errors.New("expected forbiddenmarkers.Initializer() to be of type initializer.ConfigurableAnalyzerInitializer, but was not")
 
 func newAnalyzer() *analysis.Analyzer {
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package norecursivetypes
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const name = "norecursivetypes"
+
+//nolint:gochecknoglobals
+var recursiveTypeCheck = checks.New(name, "recursive-type", config.SeverityError)
+
+func init() {
+	checks.DefaultRegistry().Register(recursiveTypeCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderRootMarker,
+		markers.KubebuilderSchemaLessMarker,
+	)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *NoRecursiveTypesConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &NoRecursiveTypesConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Recursive types cannot be expressed in the schemas generated for CRDs. Break the cycle with a schemaless field or type, or restructure the types.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+// rootType is a root object type from which the type references are walked.
+type rootType struct {
+	typeSpec *ast.TypeSpec
+	named    *types.Named
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	var w *cycleWalker
+
+	roots := []rootType{}
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		if w == nil {
+			w = newCycleWalker(pass, markersAccess)
+		}
+
+		obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+		if !ok {
+			return
+		}
+
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			return
+		}
+
+		typeMarkers := markersAccess.TypeMarkers(typeSpec)
+
+		if typeMarkers.Has(markers.KubebuilderSchemaLessMarker) {
+			// The schema of a schemaless type is not generated, and so it breaks any cycle through it.
+			w.schemaless.Insert(named)
+		}
+
+		if utils.IsRootType(typeSpec, typeMarkers) {
+			roots = append(roots, rootType{typeSpec: typeSpec, named: named})
+		}
+	})
+
+	// The roots are walked once every schemaless type is known.
+	for _, root := range roots {
+		w.walkRoot(root.typeSpec, root.named)
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// frame is a named type on the path currently being walked.
+type frame struct {
+	named *types.Named
+
+	// path is the serialized path, within the named type, to the next type on the stack.
+	path string
+}
+
+// String returns the frame in the form `Type.path`.
+func (f frame) String() string {
+	switch {
+	case f.path == "":
+		return f.named.Obj().Name()
+	case strings.HasPrefix(f.path, "[") || strings.HasPrefix(f.path, "."):
+		return f.named.Obj().Name() + f.path
+	default:
+		return f.named.Obj().Name() + "." + f.path
+	}
+}
+
+// cycleWalker walks the type reference graph from root objects, following fields, aliases, slices, maps and pointers,
+// and reports each reference that closes a cycle.
+// Types are walked using the type information so that cycles through types from other packages are found.
+type cycleWalker struct {
+	pass    *analysis.Pass
+	markers markershelper.Markers
+	fields  map[token.Pos]*ast.Field
+
+	// root is the root object type currently being walked.
+	root *ast.TypeSpec
+
+	// done contains the named types that have been completely walked.
+	// Any cycle through these types has already been reported.
+	done sets.Set[*types.Named]
+
+	// reported contains the fields that close a cycle that has already been reported.
+	reported sets.Set[*types.Var]
+
+	// schemaless contains the named types marked as schemaless within the package being analyzed.
+	// These types are not walked, as they break any cycle through them.
+	schemaless sets.Set[*types.Named]
+}
+
+func newCycleWalker(pass *analysis.Pass, markersAccess markershelper.Markers) *cycleWalker {
+	return &cycleWalker{
+		pass:       pass,
+		markers:    markersAccess,
+		fields:     utils.FieldsByPos(pass),
+		done:       sets.New[*types.Named](),
+		reported:   sets.New[*types.Var](),
+		schemaless: sets.New[*types.Named](),
+	}
+}
+
+func (w *cycleWalker) walkRoot(typeSpec *ast.TypeSpec, named *types.Named) {
+	w.root = typeSpec
+	w.walkNamed(named, nil, nil)
+}
+
+// walkNamed walks the underlying type of the named type.
+// When the named type is already on the stack, the reference closes a cycle, and is reported.
+func (w *cycleWalker) walkNamed(named *types.Named, stack []frame, field *types.Var) {
+	for i, f := range stack {
+		if f.named == named {
+			w.reportCycle(stack[i:], named, field)
+			return
+		}
+	}
+
+	if w.done.Has(named) || w.schemaless.Has(named) {
+		return
+	}
+
+	stack = append(stack, frame{named: named})
+	w.walkType(named.Underlying(), "", stack, field)
+
+	w.done.Insert(named)
+}
+
+// walkType descends through the type to find the named types that it references.
+// The field is the struct field through which the type was reached, if any.
+func (w *cycleWalker) walkType(typ types.Type, path string, stack []frame, field *types.Var) {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Named:
+		stack[len(stack)-1].path = path
+		w.walkNamed(t, stack, field)
+	case *types.Pointer:
+		w.walkType(t.Elem(), path, stack, field)
+	case *types.Slice:
+		w.walkType(t.Elem(), path+utils.ListElementPathSegment, stack, field)
+	case *types.Array:
+		w.walkType(t.Elem(), path+utils.ListElementPathSegment, stack, field)
+	case *types.Map:
+		w.walkType(t.Elem(), path+utils.MapValuePathSegment, stack, field)
+	case *types.Struct:
+		w.walkStruct(t, path, stack)
+	}
+}
+
+// walkStruct walks the type of each serialized field of the struct.
+// Fields marked as schemaless are not walked, as they break any cycle through them.
+func (w *cycleWalker) walkStruct(sTyp *types.Struct, path string, stack []frame) {
+	for i := range sTyp.NumFields() {
+		field := sTyp.Field(i)
+		if !field.Exported() {
+			continue
+		}
+
+		tagName, inline, ignored := utils.ParseJSONTag(sTyp.Tag(i))
+
+		switch {
+		case ignored:
+			continue
+		case inline, field.Embedded() && tagName == "":
+			w.walkType(field.Type(), path, stack, field)
+			continue
+		case tagName == "":
+			tagName = field.Name()
+		}
+
+		if astField, ok := w.fields[field.Pos()]; ok && w.markers.FieldMarkers(astField).Has(markers.KubebuilderSchemaLessMarker) {
+			continue
+		}
+
+		w.walkType(field.Type(), utils.JoinPath(path, tagName), stack, field)
+	}
+}
+
+// reportCycle reports the cycle formed by the frames, closed by a reference to the named type through the field.
+// The cycle is reported on the field that closes it where that field is declared within the package being analyzed,
+// and otherwise on the root object from which it was reached.
+func (w *cycleWalker) reportCycle(cycle []frame, named *types.Named, field *types.Var) {
+	if field == nil || w.reported.Has(field) {
+		return
+	}
+
+	w.reported.Insert(field)
+
+	cyclePath := make([]string, 0, len(cycle)+1)
+	for _, f := range cycle {
+		cyclePath = append(cyclePath, f.String())
+	}
+
+	cyclePath = append(cyclePath, named.Obj().Name())
+
+	pos := w.root.Pos()
+	if astField, ok := w.fields[field.Pos()]; ok {
+		pos = astField.Pos()
+	}
+
+	recursiveTypeCheck.Reportf(w.pass, pos, "type %s is recursive, reachable from root type %s: %s. Recursive types cannot be expressed in the generated schema, mark a field or type within the cycle with %s to break it", named.Obj().Name(), w.root.Name.Name, strings.Join(cyclePath, " -> "), markers.KubebuilderSchemaLessMarker)
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `norecursivetypes` linter checks that the types reachable from root objects do not reference themselves.
+
+The OpenAPI schemas generated for CRDs cannot express recursive types, so a type such as
+`type Node struct { Children []Node }` fails only when the schema is generated.
+
+The linter follows the type references from each root object, that is, a type marked with `+kubebuilder:object:root=true`
+or embedding `TypeMeta`, through fields, aliases, slices, maps and pointers, including types from other packages.
+Each reference that closes a cycle is reported, along with the path of the cycle, e.g. `Node.children[*] -> Node`.
+
+Fields and types marked with `+kubebuilder:validation:Schemaless` are not followed, and so can be used to break a cycle.
+*/
+package norecursivetypes
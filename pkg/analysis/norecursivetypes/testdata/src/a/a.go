@@ -0,0 +1,90 @@
+package a
+
+// +kubebuilder:object:root=true
+type Tree struct {
+	Spec TreeSpec `json:"spec"`
+}
+
+type TreeSpec struct {
+	Root Node `json:"root"`
+}
+
+type Node struct {
+	Name string `json:"name"`
+
+	Children []Node `json:"children"` // want "type Node is recursive, reachable from root type Tree: Node.children\\[\\*\\] -> Node. Recursive types cannot be expressed in the generated schema, mark a field or type within the cycle with kubebuilder:validation:Schemaless to break it"
+}
+
+// +kubebuilder:object:root=true
+type Graph struct {
+	Spec GraphSpec `json:"spec"`
+}
+
+type GraphSpec struct {
+	Vertex *Vertex `json:"vertex"`
+
+	// Tree reaches Node, which has already been reported.
+	Tree TreeSpec `json:"tree"`
+}
+
+type Vertex struct {
+	Edges map[string]Edge `json:"edges"`
+}
+
+type Edge struct {
+	Target Targets `json:"target"` // want "type Vertex is recursive, reachable from root type Graph: Vertex.edges.\\* -> Edge.target -> Targets\\[\\*\\] -> Vertex"
+}
+
+type Targets []*Vertex
+
+// +kubebuilder:object:root=true
+type Embedded struct {
+	Spec EmbeddedSpec `json:"spec"`
+}
+
+type EmbeddedSpec struct {
+	Common `json:",inline"`
+}
+
+type Common struct {
+	Parent *EmbeddedSpec `json:"parent"` // want "type EmbeddedSpec is recursive, reachable from root type Embedded: EmbeddedSpec -> Common.parent -> EmbeddedSpec"
+}
+
+// +kubebuilder:object:root=true
+type Broken struct {
+	Spec BrokenSpec `json:"spec"`
+}
+
+type BrokenSpec struct {
+	// Children are validated by the controller.
+	// +kubebuilder:validation:Schemaless
+	Children []BrokenSpec `json:"children"`
+
+	Ignored []BrokenSpec `json:"-"`
+
+	unexported []BrokenSpec
+}
+
+// +kubebuilder:object:root=true
+type SchemalessType struct {
+	Spec SchemalessTypeSpec `json:"spec"`
+}
+
+type SchemalessTypeSpec struct {
+	Expression Expression `json:"expression"`
+}
+
+type Expression struct {
+	Operands []Operand `json:"operands"`
+}
+
+// Operand is validated by the controller.
+// +kubebuilder:validation:Schemaless
+type Operand struct {
+	Expression *Expression `json:"expression"`
+}
+
+// NotRoot is recursive, but is not reachable from a root object.
+type NotRoot struct {
+	Next *NotRoot `json:"next"`
+}
@@ -21,6 +21,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/namingconventions"
 )
@@ -30,6 +31,10 @@ const (
 	doc  = "Enforces that fields use Ref/Refs and not Reference/References"
 )
 
+func init() {
+	checks.DefaultRegistry().Register(namingconventions.Check(name))
+}
+
 var (
 	errUnexpectedInitializerType = errors.New("expected namingconventions.Initializer() to be of type initializer.ConfigurableAnalyzerInitializer, but was not")
 	errInvalidPolicy             = errors.New("invalid policy")
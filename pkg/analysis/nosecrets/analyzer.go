@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package nosecrets
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const name = "nosecrets"
+
+//nolint:gochecknoglobals
+var inlineSecretCheck = checks.New(name, "inline-secret", config.SeverityWarning)
+
+//nolint:gochecknoglobals
+var (
+	defaultSensitiveFieldNames = []string{"Password", "Passwd", "Passphrase", "Token", "Secret", "APIKey", "PrivateKey", "AccessKey", "SecretKey", "Credential", "Credentials"}
+
+	// valueSuffixes may follow a sensitive word at the end of a field name, e.g. `TokenData`.
+	valueSuffixes = []string{"Data", "Value", "Bytes"}
+)
+
+func init() {
+	checks.DefaultRegistry().Register(inlineSecretCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderRootMarker,
+	)
+}
+
+type analyzer struct {
+	sensitiveFieldNames []string
+	allowedFields       sets.Set[string]
+	checks              checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *NoSecretsConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &NoSecretsConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		sensitiveFieldNames: cfg.SensitiveFieldNames,
+		allowedFields:       sets.New(cfg.AllowedFields...),
+		checks:              cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that fields reachable from root objects do not hold secret material, such as passwords or tokens, inline. Secret material should be stored in a Secret, and referenced from the object.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func defaultConfig(cfg *NoSecretsConfig) {
+	if len(cfg.SensitiveFieldNames) == 0 {
+		cfg.SensitiveFieldNames = defaultSensitiveFieldNames
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	reachable := newReachableFields()
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		if !utils.IsRootType(typeSpec, markersAccess.TypeMarkers(typeSpec)) {
+			return
+		}
+
+		if obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName); ok {
+			reachable.walk(obj.Type())
+		}
+	})
+
+	inspect.InspectFields(func(field *ast.Field, jsonTagInfo extractjsontags.FieldTagInfo, _ markershelper.Markers, qualifiedFieldName string) {
+		if len(field.Names) == 0 || !reachable.has(pass, field.Names[0]) {
+			return
+		}
+
+		a.checkField(pass, field, jsonTagInfo, qualifiedFieldName)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkField reports string and []byte fields whose name implies that they hold secret material.
+func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, jsonTagInfo extractjsontags.FieldTagInfo, qualifiedFieldName string) {
+	if a.allowedFields.Has(jsonTagInfo.Name) || a.allowedFields.Has(qualifiedFieldName) {
+		return
+	}
+
+	word := a.sensitiveWord(utils.FieldName(field))
+	if word == "" {
+		return
+	}
+
+	typ := pass.TypesInfo.TypeOf(field.Type)
+	if typ == nil || !isStringOrBytes(typ) {
+		return
+	}
+
+	inlineSecretCheck.Reportf(pass, field.Pos(), "field %s appears to hold secret material (%s), which should not be stored inline in the object. Store the value in a Secret, and reference it instead, e.g. with a field of type corev1.SecretKeySelector, or a struct containing the name of the Secret", qualifiedFieldName, word)
+}
+
+// sensitiveWord returns the sensitive word that ends the field name, optionally followed by a value suffix,
+// or an empty string when the field name does not imply that the field holds secret material.
+func (a *analyzer) sensitiveWord(fieldName string) string {
+	for _, suffix := range valueSuffixes {
+		if trimmed, ok := strings.CutSuffix(fieldName, suffix); ok && trimmed != "" {
+			fieldName = trimmed
+			break
+		}
+	}
+
+	for _, word := range a.sensitiveFieldNames {
+		if strings.HasSuffix(fieldName, word) {
+			return word
+		}
+	}
+
+	return ""
+}
+
+// isStringOrBytes determines whether the type, or the type it points to, is a string or a byte slice.
+func isStringOrBytes(typ types.Type) bool {
+	switch t := utils.DerefType(typ).Underlying().(type) {
+	case *types.Basic:
+		return t.Info()&types.IsString != 0
+	case *types.Slice:
+		elem, ok := t.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	default:
+		return false
+	}
+}
+
+// reachableFields contains the struct fields reachable from root objects.
+type reachableFields struct {
+	named  sets.Set[*types.Named]
+	fields sets.Set[token.Pos]
+}
+
+func newReachableFields() *reachableFields {
+	return &reachableFields{
+		named:  sets.New[*types.Named](),
+		fields: sets.New[token.Pos](),
+	}
+}
+
+// has determines whether the field, identified by its name, is reachable from a root object.
+func (r *reachableFields) has(pass *analysis.Pass, ident *ast.Ident) bool {
+	obj, ok := pass.TypesInfo.Defs[ident].(*types.Var)
+
+	return ok && r.fields.Has(obj.Pos())
+}
+
+// walk records the struct fields reachable from the type, following named types, pointers, slices, arrays and maps.
+func (r *reachableFields) walk(typ types.Type) {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Named:
+		if r.named.Has(t) {
+			return
+		}
+
+		r.named.Insert(t)
+		r.walk(t.Underlying())
+	case *types.Pointer:
+		r.walk(t.Elem())
+	case *types.Slice:
+		r.walk(t.Elem())
+	case *types.Array:
+		r.walk(t.Elem())
+	case *types.Map:
+		r.walk(t.Elem())
+	case *types.Struct:
+		for field := range t.Fields() {
+			r.fields.Insert(field.Pos())
+			r.walk(field.Type())
+		}
+	}
+}
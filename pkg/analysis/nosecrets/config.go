@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package nosecrets
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// NoSecretsConfig contains configuration for the nosecrets linter.
+type NoSecretsConfig struct {
+	// sensitiveFieldNames is a list of words that, when they end the name of a string or []byte field,
+	// imply that the field holds secret material, such as a password or a token.
+	// The words may be followed by `Data`, `Value` or `Bytes`, e.g. `Token` matches both `BearerToken` and `TokenData`.
+	// Words are matched at camel case word boundaries, and so must start with an upper case letter.
+	// When omitted, the words `Password`, `Passwd`, `Passphrase`, `Token`, `Secret`, `APIKey`, `PrivateKey`, `AccessKey`,
+	// `SecretKey`, `Credential` and `Credentials` are used.
+	SensitiveFieldNames []string `json:"sensitiveFieldNames"`
+
+	// allowedFields is a list of fields that are not reported, even though their name implies that they hold secret material.
+	// Each entry is either the JSON name of a field, e.g. `continueToken`, to allow the field in any struct,
+	// or the qualified Go name of a field, e.g. `ListOptions.ContinueToken`, to allow only that field.
+	AllowedFields []string `json:"allowedFields"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `inline-secret`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
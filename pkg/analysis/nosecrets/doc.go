@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `nosecrets` linter checks that fields reachable from root objects do not hold secret material inline.
+
+Secret material, such as passwords, tokens and private keys, should be stored in a Secret,
+and referenced from the object, e.g. with a field of type `corev1.SecretKeySelector`,
+rather than being stored within the object itself, where it is visible to anyone able to read the object.
+
+The linter reports string and `[]byte` fields, in any struct reachable from a root object,
+with a name ending in a sensitive word, such as `Password`, `Token`, `Secret`, `APIKey` or `PrivateKey`.
+The sensitive word may be followed by `Data`, `Value` or `Bytes`, e.g. `TokenData`.
+Fields referencing secrets, such as `passwordSecretRef` or `passwordSecretName`, are not reported,
+as their names do not end in a sensitive word.
+
+The sensitive words are configurable, and individual fields may be allowed, by their JSON name or their qualified Go name.
+*/
+package nosecrets
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package nosecrets
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
+)
+
+func init() {
+	registry.DefaultRegistry().RegisterLinter(Initializer())
+}
+
+// Initializer returns the AnalyzerInitializer for this
+// Analyzer so that it can be added to the registry.
+func Initializer() initializer.AnalyzerInitializer {
+	return initializer.NewConfigurableInitializer(
+		name,
+		initAnalyzer,
+		false,
+		validateConfig,
+	)
+}
+
+// initAnalyzer returns the initialized Analyzer.
+func initAnalyzer(cfg *NoSecretsConfig) (*analysis.Analyzer, error) {
+	return newAnalyzer(cfg), nil
+}
+
+// validateConfig is used to validate the configuration in the NoSecretsConfig struct.
+func validateConfig(cfg *NoSecretsConfig, fldPath *field.Path) field.ErrorList {
+	if cfg == nil {
+		return field.ErrorList{}
+	}
+
+	fieldErrors := field.ErrorList{}
+
+	seenWords := sets.New[string]()
+
+	for i, word := range cfg.SensitiveFieldNames {
+		if seenWords.Has(word) {
+			fieldErrors = append(fieldErrors, field.Duplicate(fldPath.Child("sensitiveFieldNames").Index(i), word))
+			continue
+		}
+
+		seenWords.Insert(word)
+
+		if r, _ := utf8.DecodeRuneInString(word); !unicode.IsUpper(r) {
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("sensitiveFieldNames").Index(i), word, "must start with an upper case letter"))
+		}
+	}
+
+	seenFields := sets.New[string]()
+
+	for i, allowed := range cfg.AllowedFields {
+		switch {
+		case strings.TrimSpace(allowed) == "":
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("allowedFields").Index(i), allowed, "allowed field cannot be empty"))
+		case seenFields.Has(allowed):
+			fieldErrors = append(fieldErrors, field.Duplicate(fldPath.Child("allowedFields").Index(i), allowed))
+		}
+
+		seenFields.Insert(allowed)
+	}
+
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(cfg.Checks, name, fldPath.Child("checks"))...)
+
+	return fieldErrors
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package nosecrets_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/nosecrets"
+)
+
+var _ = Describe("nosecrets initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      nosecrets.NoSecretsConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := nosecrets.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("nosecrets"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid NoSecretsConfig", testCase{
+				config:      nosecrets.NoSecretsConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid NoSecretsConfig: SensitiveFieldNames and AllowedFields", testCase{
+				config: nosecrets.NoSecretsConfig{
+					SensitiveFieldNames: []string{"Password", "Token"},
+					AllowedFields:       []string{"continueToken", "ListOptions.ContinueToken"},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid NoSecretsConfig: SensitiveFieldNames: lower case word", testCase{
+				config: nosecrets.NoSecretsConfig{
+					SensitiveFieldNames: []string{"password"},
+				},
+				expectedErr: "nosecrets.sensitiveFieldNames[0]: Invalid value: \"password\": must start with an upper case letter",
+			}),
+			Entry("With an invalid NoSecretsConfig: SensitiveFieldNames: duplicate word", testCase{
+				config: nosecrets.NoSecretsConfig{
+					SensitiveFieldNames: []string{"Password", "Password"},
+				},
+				expectedErr: "nosecrets.sensitiveFieldNames[1]: Duplicate value: \"Password\"",
+			}),
+			Entry("With an invalid NoSecretsConfig: AllowedFields: empty field", testCase{
+				config: nosecrets.NoSecretsConfig{
+					AllowedFields: []string{""},
+				},
+				expectedErr: "nosecrets.allowedFields[0]: Invalid value: \"\": allowed field cannot be empty",
+			}),
+			Entry("With an invalid NoSecretsConfig: AllowedFields: duplicate field", testCase{
+				config: nosecrets.NoSecretsConfig{
+					AllowedFields: []string{"continueToken", "continueToken"},
+				},
+				expectedErr: "nosecrets.allowedFields[1]: Duplicate value: \"continueToken\"",
+			}),
+			Entry("With an invalid NoSecretsConfig: Checks: unknown check", testCase{
+				config: nosecrets.NoSecretsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "nosecrets.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: inline-secret",
+			}),
+		)
+	})
+})
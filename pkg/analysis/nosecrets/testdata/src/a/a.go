@@ -0,0 +1,82 @@
+package a
+
+// +kubebuilder:object:root=true
+type Database struct {
+	Spec DatabaseSpec `json:"spec"`
+}
+
+type DatabaseSpec struct {
+	// password is the password of the database.
+	// +optional
+	Password string `json:"password,omitempty"` // want "field DatabaseSpec.Password appears to hold secret material \\(Password\\), which should not be stored inline in the object. Store the value in a Secret, and reference it instead, e.g. with a field of type corev1.SecretKeySelector, or a struct containing the name of the Secret"
+
+	// adminPassword is a pointer to the admin password.
+	// +optional
+	AdminPassword *string `json:"adminPassword,omitempty"` // want "field DatabaseSpec.AdminPassword appears to hold secret material \\(Password\\)"
+
+	// apiKey is the API key.
+	// +optional
+	APIKey string `json:"apiKey,omitempty"` // want "field DatabaseSpec.APIKey appears to hold secret material \\(APIKey\\)"
+
+	// privateKey is the private key.
+	// +optional
+	PrivateKey []byte `json:"privateKey,omitempty"` // want "field DatabaseSpec.PrivateKey appears to hold secret material \\(PrivateKey\\)"
+
+	// tokenData is the token.
+	// +optional
+	TokenData Data `json:"tokenData,omitempty"` // want "field DatabaseSpec.TokenData appears to hold secret material \\(Token\\)"
+
+	// passwordSecretRef is a reference to the secret holding the password.
+	// +optional
+	PasswordSecretRef *SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// passwordSecretName is the name of the secret holding the password.
+	// +optional
+	PasswordSecretName string `json:"passwordSecretName,omitempty"`
+
+	// tokenAudience is the audience of the token.
+	// +optional
+	TokenAudience string `json:"tokenAudience,omitempty"`
+
+	// maxTokens is the maximum number of tokens.
+	// +optional
+	MaxTokens int32 `json:"maxTokens,omitempty"`
+
+	// tokenizer is not a token.
+	// +optional
+	Tokenizer string `json:"tokenizer,omitempty"`
+
+	// client is the client configuration.
+	// +optional
+	Client ClientConfig `json:"client,omitempty"`
+}
+
+type ClientConfig struct {
+	// clientSecret is the client secret.
+	// +optional
+	ClientSecret string `json:"clientSecret,omitempty"` // want "field ClientConfig.ClientSecret appears to hold secret material \\(Secret\\)"
+
+	// credentials are the client credentials.
+	// +optional
+	Credentials []byte `json:"credentials,omitempty"` // want "field ClientConfig.Credentials appears to hold secret material \\(Credentials\\)"
+}
+
+type SecretKeySelector struct {
+	// name is the name of the secret.
+	// +required
+	Name string `json:"name"`
+
+	// key is the key within the secret.
+	// +required
+	Key string `json:"key"`
+}
+
+// Data is a byte slice.
+type Data []byte
+
+// Unreachable is not reachable from a root object.
+type Unreachable struct {
+	// password is not reported, as the type is not reachable from a root object.
+	// +optional
+	Password string `json:"password,omitempty"`
+}
@@ -0,0 +1,28 @@
+package b
+
+// +kubebuilder:object:root=true
+type Database struct {
+	Spec DatabaseSpec `json:"spec"`
+}
+
+type DatabaseSpec struct {
+	// password is the password of the database.
+	// +optional
+	Password string `json:"password,omitempty"` // want "field DatabaseSpec.Password appears to hold secret material \\(Password\\)"
+
+	// continueToken is allowed by its JSON name.
+	// +optional
+	ContinueToken string `json:"continueToken,omitempty"`
+
+	// bootstrapToken is allowed by its qualified name.
+	// +optional
+	BootstrapToken string `json:"bootstrapToken,omitempty"`
+
+	// sessionToken is not allowed.
+	// +optional
+	SessionToken string `json:"sessionToken,omitempty"` // want "field DatabaseSpec.SessionToken appears to hold secret material \\(Token\\)"
+
+	// certificate is a configured sensitive field name.
+	// +optional
+	Certificate []byte `json:"certificate,omitempty"` // want "field DatabaseSpec.Certificate appears to hold secret material \\(Certificate\\)"
+}
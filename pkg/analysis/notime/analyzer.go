@@ -0,0 +1,321 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package notime
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+const (
+	name = "notime"
+
+	timePkgPath   = "time"
+	metav1PkgPath = "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1Name    = "metav1"
+)
+
+//nolint:gochecknoglobals
+var (
+	timeTypeCheck  = checks.New(name, "time-type", config.SeverityWarning)
+	microTimeCheck = checks.New(name, "microtime", config.SeverityWarning)
+	fieldNameCheck = checks.New(name, "field-name", config.SeverityInfo)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(timeTypeCheck, microTimeCheck, fieldNameCheck)
+}
+
+type analyzer struct {
+	microTimePolicy MicroTimePolicy
+	fieldNamePolicy FieldNamePolicy
+	checks          checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *NoTimeConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &NoTimeConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		microTimePolicy: cfg.MicroTimePolicy,
+		fieldNamePolicy: cfg.FieldNamePolicy,
+		checks:          cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that time.Time is not used within API types. Use metav1.Time instead, which serializes consistently and handles null values.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+// timeUsage is a use of time.Time found within the API types.
+type timeUsage struct {
+	// expr is the time.Time selector expression.
+	expr *ast.SelectorExpr
+
+	// node is the field or type spec that the usage is reported against.
+	node ast.Node
+
+	// prefix describes the node for the diagnostic message.
+	prefix string
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	usages := []timeUsage{}
+
+	typeChecker := utils.NewTypeChecker(isTimeType, func(_ *analysis.Pass, expr ast.Expr, node ast.Node, prefix string) {
+		if sel, ok := expr.(*ast.SelectorExpr); ok {
+			usages = append(usages, timeUsage{expr: sel, node: node, prefix: prefix})
+		}
+	})
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, _ markers.Markers, qualifiedFieldName string) {
+		typeChecker.CheckNode(pass, field)
+		a.checkMicroTime(pass, field, qualifiedFieldName)
+		a.checkFieldName(pass, field, qualifiedFieldName)
+	})
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, _ markers.Markers) {
+		typeChecker.CheckNode(pass, typeSpec)
+	})
+
+	reportTimeUsages(pass, usages)
+
+	return nil, nil //nolint:nilnil
+}
+
+// reportTimeUsages reports each use of time.Time.
+// Where the time.Time type is used directly by the reported node, a fix is suggested
+// to replace it with metav1.Time.
+func reportTimeUsages(pass *analysis.Pass, usages []timeUsage) {
+	fixableFiles := map[*ast.SelectorExpr]*ast.File{}
+	fixable := map[*ast.File][]*ast.SelectorExpr{}
+
+	for _, usage := range usages {
+		if _, ok := fixableFiles[usage.expr]; ok || usage.node.Pos() > usage.expr.Pos() || usage.expr.End() > usage.node.End() {
+			continue
+		}
+
+		file := fileForNode(pass, usage.expr)
+		if file == nil {
+			continue
+		}
+
+		fixableFiles[usage.expr] = file
+		fixable[file] = append(fixable[file], usage.expr)
+	}
+
+	for _, exprs := range fixable {
+		slices.SortFunc(exprs, func(a, b *ast.SelectorExpr) int {
+			return cmp.Compare(a.Pos(), b.Pos())
+		})
+	}
+
+	for _, usage := range usages {
+		diag := analysis.Diagnostic{
+			Pos:     usage.node.Pos(),
+			Message: fmt.Sprintf("%s should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values.", usage.prefix),
+		}
+
+		if file, ok := fixableFiles[usage.expr]; ok {
+			diag.SuggestedFixes = timeFixes(pass, file, usage.expr, fixable[file])
+		}
+
+		timeTypeCheck.Report(pass, diag)
+	}
+}
+
+// timeFixes returns the suggested fixes to replace the time.Time expression with metav1.Time.
+// The fixable expressions are every time.Time expression within the file that is being replaced, in order.
+//
+// Each fix may be applied on its own, so each fix adds the metav1 import when it is missing,
+// and only the fix for the last expression within the file removes the time import.
+// That fix also replaces each of the other expressions, so that no use of the time import remains.
+func timeFixes(pass *analysis.Pass, file *ast.File, expr *ast.SelectorExpr, fixable []*ast.SelectorExpr) []analysis.SuggestedFix {
+	pkgName, hasImport := importName(file, metav1PkgPath)
+	if !hasImport {
+		pkgName = metav1Name
+	}
+
+	timeSpec, timeDecl, removeTime := removableTimeImport(pass, file, fixable)
+	removeTime = removeTime && expr == fixable[len(fixable)-1]
+
+	exprs := []*ast.SelectorExpr{expr}
+	message := fmt.Sprintf("replace time.Time with %s.Time", pkgName)
+
+	if removeTime {
+		exprs = fixable
+		message = fmt.Sprintf("replace each use of time.Time within the file with %s.Time, and remove the time import", pkgName)
+	}
+
+	edits := []analysis.TextEdit{}
+
+	for _, e := range exprs {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     e.Pos(),
+			End:     e.End(),
+			NewText: []byte(pkgName + ".Time"),
+		})
+	}
+
+	if !hasImport {
+		edits = append(edits, addImportEdit(file, metav1Name, metav1PkgPath))
+	}
+
+	if removeTime {
+		// When the metav1 import is added to the declaration containing the time import,
+		// the declaration must be kept so that the edits do not overlap.
+		keepDecl := !hasImport && timeDecl == lastImportDecl(file)
+		edits = append(edits, removeImportEdit(pass, timeSpec, timeDecl, keepDecl))
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   message,
+			TextEdits: edits,
+		},
+	}
+}
+
+func defaultConfig(cfg *NoTimeConfig) {
+	if cfg.MicroTimePolicy == "" {
+		cfg.MicroTimePolicy = MicroTimePolicyAllow
+	}
+
+	if cfg.FieldNamePolicy == "" {
+		cfg.FieldNamePolicy = FieldNamePolicyIgnore
+	}
+}
+
+// checkMicroTime reports fields using metav1.MicroTime outside of event-style types.
+func (a *analyzer) checkMicroTime(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string) {
+	if a.microTimePolicy != MicroTimePolicyEventsOnly {
+		return
+	}
+
+	_, typ := utils.IsStarExpr(field.Type)
+
+	sel, ok := typ.(*ast.SelectorExpr)
+	if !ok || !utils.IsNamedType(pass, sel, metav1PkgPath, "MicroTime") {
+		return
+	}
+
+	if strings.Contains(utils.GetStructName(pass, field), "Event") {
+		return
+	}
+
+	microTimeCheck.Report(pass, analysis.Diagnostic{
+		Pos:     field.Pos(),
+		Message: fmt.Sprintf("field %s should not use metav1.MicroTime outside of event types. Use metav1.Time instead.", qualifiedFieldName),
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message: "replace metav1.MicroTime with metav1.Time",
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     sel.Sel.Pos(),
+						End:     sel.Sel.End(),
+						NewText: []byte("Time"),
+					},
+				},
+			},
+		},
+	})
+}
+
+// checkFieldName reports fields using metav1.Time or metav1.MicroTime whose names do not end with `Time`.
+func (a *analyzer) checkFieldName(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string) {
+	if a.fieldNamePolicy != FieldNamePolicyRequireTimeSuffix || len(field.Names) == 0 {
+		return
+	}
+
+	_, typ := utils.IsStarExpr(field.Type)
+
+	sel, ok := typ.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	if !utils.IsNamedType(pass, sel, metav1PkgPath, "Time") && !utils.IsNamedType(pass, sel, metav1PkgPath, "MicroTime") {
+		return
+	}
+
+	if strings.HasSuffix(field.Names[0].Name, "Time") {
+		return
+	}
+
+	fieldNameCheck.Reportf(pass, field.Pos(), "field %s uses a time type, its name should end with 'Time'", qualifiedFieldName)
+}
+
+// isTimeType determines whether the expression refers to time.Time.
+func isTimeType(pass *analysis.Pass, expr ast.Expr) bool {
+	return utils.IsNamedType(pass, expr, timePkgPath, "Time")
+}
+
+// fileForNode returns the file within the pass that contains the node.
+func fileForNode(pass *analysis.Pass, node ast.Node) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= node.Pos() && node.End() <= file.FileEnd {
+			return file
+		}
+	}
+
+	return nil
+}
+
+// importName returns the name by which the package is imported within the file,
+// and whether the file imports the package.
+func importName(file *ast.File, pkgPath string) (string, bool) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != pkgPath {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name, true
+		}
+
+		return pkgPath[strings.LastIndex(pkgPath, "/")+1:], true
+	}
+
+	return "", false
+}
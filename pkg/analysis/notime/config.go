@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package notime
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// MicroTimePolicy is the policy for fields using metav1.MicroTime.
+type MicroTimePolicy string
+
+const (
+	// MicroTimePolicyAllow allows metav1.MicroTime to be used in any type.
+	MicroTimePolicyAllow MicroTimePolicy = "Allow"
+
+	// MicroTimePolicyEventsOnly only allows metav1.MicroTime to be used within event-style types.
+	MicroTimePolicyEventsOnly MicroTimePolicy = "EventsOnly"
+)
+
+// FieldNamePolicy is the policy for the names of fields using metav1.Time or metav1.MicroTime.
+type FieldNamePolicy string
+
+const (
+	// FieldNamePolicyIgnore does not check the names of time fields.
+	FieldNamePolicyIgnore FieldNamePolicy = "Ignore"
+
+	// FieldNamePolicyRequireTimeSuffix requires the names of time fields to end with `Time`.
+	FieldNamePolicyRequireTimeSuffix FieldNamePolicy = "RequireTimeSuffix"
+)
+
+// NoTimeConfig contains configuration for the notime linter.
+type NoTimeConfig struct {
+	// microTimePolicy determines whether fields may use metav1.MicroTime.
+	// Valid values are "Allow" and "EventsOnly".
+	// When set to "Allow", metav1.MicroTime may be used in any type.
+	// When set to "EventsOnly", metav1.MicroTime may only be used within event-style types,
+	// that is, types whose name contains `Event`. Other fields should use metav1.Time instead.
+	// When otherwise not specified, the default value is "Allow".
+	MicroTimePolicy MicroTimePolicy `json:"microTimePolicy"`
+
+	// fieldNamePolicy determines whether the names of fields using metav1.Time or metav1.MicroTime are checked.
+	// Valid values are "Ignore" and "RequireTimeSuffix".
+	// When set to "RequireTimeSuffix", the names of time fields must end with `Time`, e.g. `LastTransitionTime`.
+	// When otherwise not specified, the default value is "Ignore".
+	FieldNamePolicy FieldNamePolicy `json:"fieldNamePolicy"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `time-type`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `notime` linter checks that fields in the API types do not use the `Time` type from the `time` package.
+
+A `time.Time` serializes with nanosecond precision, and serializes its zero value as `0001-01-01T00:00:00Z` rather than `null`.
+Instead, API types should use `metav1.Time`, which serializes as an RFC3339 timestamp with second precision
+and serializes the zero value as `null`.
+
+The linter reports fields using `time.Time`, pointers to and collections of `time.Time`, and types that alias `time.Time`.
+Where the linter reports the `time.Time` type directly, it suggests a fix to use `metav1.Time` instead,
+adding the `k8s.io/apimachinery/pkg/apis/meta/v1` import where required, and removing the `time` import once it is no longer used.
+
+Optionally, the linter can also report fields using `metav1.MicroTime` outside of event-style types,
+and fields using `metav1.Time` or `metav1.MicroTime` whose names do not end with `Time`,
+in keeping with the `notimestamp` naming convention.
+*/
+package notime
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package notime
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// addImportEdit returns an edit that adds the named import to the file.
+// The import is added to the end of the last import declaration, or after the
+// package clause when the file has no imports.
+func addImportEdit(file *ast.File, pkgName, pkgPath string) analysis.TextEdit {
+	lastImport := lastImportDecl(file)
+
+	switch {
+	case lastImport == nil:
+		return analysis.TextEdit{
+			Pos:     file.Name.End(),
+			End:     file.Name.End(),
+			NewText: fmt.Appendf(nil, "\n\nimport %s %q", pkgName, pkgPath),
+		}
+	case lastImport.Lparen.IsValid():
+		return analysis.TextEdit{
+			Pos:     lastImport.Rparen,
+			End:     lastImport.Rparen,
+			NewText: fmt.Appendf(nil, "\t%s %q\n", pkgName, pkgPath),
+		}
+	default:
+		return analysis.TextEdit{
+			Pos:     lastImport.End(),
+			End:     lastImport.End(),
+			NewText: fmt.Appendf(nil, "\n\nimport %s %q", pkgName, pkgPath),
+		}
+	}
+}
+
+// lastImportDecl returns the last import declaration of the file, or nil when the file has no imports.
+func lastImportDecl(file *ast.File) *ast.GenDecl {
+	var lastImport *ast.GenDecl
+
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			lastImport = genDecl
+		}
+	}
+
+	return lastImport
+}
+
+// removableTimeImport returns the time import of the file, and the declaration containing it,
+// when every use of the time package within the file is a time.Time expression that is being replaced.
+func removableTimeImport(pass *analysis.Pass, file *ast.File, fixable []*ast.SelectorExpr) (*ast.ImportSpec, *ast.GenDecl, bool) {
+	spec, decl := findImport(file, timePkgPath)
+	if spec == nil || spec.Name != nil && (spec.Name.Name == "_" || spec.Name.Name == ".") {
+		// Blank and dot imports are left alone.
+		return nil, nil, false
+	}
+
+	replaced := map[*ast.Ident]bool{}
+
+	for _, expr := range fixable {
+		if ident, ok := expr.X.(*ast.Ident); ok {
+			replaced[ident] = true
+		}
+	}
+
+	for ident, obj := range pass.TypesInfo.Uses {
+		pkgName, ok := obj.(*types.PkgName)
+		if !ok || pkgName.Imported().Path() != timePkgPath {
+			continue
+		}
+
+		if ident.Pos() < file.FileStart || ident.End() > file.FileEnd {
+			continue
+		}
+
+		if !replaced[ident] {
+			return nil, nil, false
+		}
+	}
+
+	return spec, decl, true
+}
+
+// removeImportEdit returns an edit that removes the import spec from the declaration.
+// The declaration is removed when the spec is its only import, unless the declaration
+// is grouped and must be kept.
+func removeImportEdit(pass *analysis.Pass, spec *ast.ImportSpec, decl *ast.GenDecl, keepDecl bool) analysis.TextEdit {
+	if !decl.Lparen.IsValid() || len(decl.Specs) == 1 && !keepDecl {
+		return analysis.TextEdit{Pos: decl.Pos(), End: decl.End()}
+	}
+
+	// Remove the whole line containing the spec within the import block.
+	tokFile := pass.Fset.File(spec.Pos())
+	line := tokFile.Line(spec.Pos())
+
+	return analysis.TextEdit{Pos: tokFile.LineStart(line), End: tokFile.LineStart(line + 1)}
+}
+
+// findImport returns the import spec for the package, and the declaration containing it.
+func findImport(file *ast.File, pkgPath string) (*ast.ImportSpec, *ast.GenDecl) {
+	quoted := fmt.Sprintf("%q", pkgPath)
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			if importSpec, ok := spec.(*ast.ImportSpec); ok && importSpec.Path.Value == quoted {
+				return importSpec, genDecl
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package notime_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/notime"
+)
+
+var _ = Describe("notime initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      notime.NoTimeConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := notime.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("notime"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid NoTimeConfig", testCase{
+				config:      notime.NoTimeConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid NoTimeConfig: MicroTimePolicy: EventsOnly", testCase{
+				config: notime.NoTimeConfig{
+					MicroTimePolicy: notime.MicroTimePolicyEventsOnly,
+				},
+				expectedErr: "",
+			}),
+			Entry("With a valid NoTimeConfig: FieldNamePolicy: RequireTimeSuffix", testCase{
+				config: notime.NoTimeConfig{
+					FieldNamePolicy: notime.FieldNamePolicyRequireTimeSuffix,
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid NoTimeConfig: MicroTimePolicy", testCase{
+				config: notime.NoTimeConfig{
+					MicroTimePolicy: "Invalid",
+				},
+				expectedErr: "notime.microTimePolicy: Invalid value: \"Invalid\": invalid value, must be one of \"Allow\", \"EventsOnly\" or omitted",
+			}),
+			Entry("With an invalid NoTimeConfig: FieldNamePolicy", testCase{
+				config: notime.NoTimeConfig{
+					FieldNamePolicy: "Invalid",
+				},
+				expectedErr: "notime.fieldNamePolicy: Invalid value: \"Invalid\": invalid value, must be one of \"Ignore\", \"RequireTimeSuffix\" or omitted",
+			}),
+			Entry("With an invalid NoTimeConfig: Checks: unknown check", testCase{
+				config: notime.NoTimeConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "notime.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: field-name,microtime,time-type",
+			}),
+		)
+	})
+})
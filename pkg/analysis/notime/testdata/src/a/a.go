@@ -0,0 +1,43 @@
+package a
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Times struct {
+	ValidString string
+
+	ValidTime metav1.Time
+
+	ValidTimePtr *metav1.Time
+
+	ValidMicroTime metav1.MicroTime
+
+	InvalidTime time.Time // want "field Times.InvalidTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimePtr *time.Time // want "field Times.InvalidTimePtr pointer should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimeSlice []time.Time // want "field Times.InvalidTimeSlice array element should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidMapStringToTime map[string]time.Time // want "field Times.InvalidMapStringToTime map value should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimeAlias TimeAlias // want "field Times.InvalidTimeAlias type TimeAlias should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimePtrAlias *TimeAlias // want "field Times.InvalidTimePtrAlias pointer type TimeAlias should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimeAliasFromAnotherFile TimeAliasB // want "field Times.InvalidTimeAliasFromAnotherFile type TimeAliasB should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	// The field name is not checked by default.
+	Created metav1.Time
+}
+
+// DoNothing is used to check that the analyser doesn't report on methods.
+func (Times) DoNothing(a bool) bool {
+	return a
+}
+
+type TimeAlias time.Time // want "type TimeAlias should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+type TimeAliasPtr *time.Time // want "type TimeAliasPtr pointer should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
@@ -0,0 +1,14 @@
+package a
+
+import (
+	"time"
+)
+
+type TimeAliasB time.Time // want "type TimeAliasB should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+type TimesWithDuration struct {
+	// The time import is still required for the duration, so it is not removed.
+	Timeout time.Duration
+
+	InvalidTime time.Time // want "field TimesWithDuration.InvalidTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+}
@@ -0,0 +1,9 @@
+package a
+
+import "time"
+
+type TimesWithoutMetaV1 struct {
+	InvalidTime time.Time // want "field TimesWithoutMetaV1.InvalidTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimePtr *time.Time // want "field TimesWithoutMetaV1.InvalidTimePtr pointer should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+}
@@ -0,0 +1,18 @@
+package a
+
+import (
+	"fmt"
+	stdtime "time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type TimesWithRenamedImports struct {
+	ValidTime v1.Time
+
+	InvalidTime stdtime.Time // want "field TimesWithRenamedImports.InvalidTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+}
+
+func (t TimesWithRenamedImports) String() string {
+	return fmt.Sprint(t.ValidTime)
+}
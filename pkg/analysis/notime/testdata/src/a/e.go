@@ -0,0 +1,11 @@
+package a
+
+import (
+	"time"
+)
+
+type TimesWithGroupedTimeImport struct {
+	InvalidTime time.Time // want "field TimesWithGroupedTimeImport.InvalidTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	InvalidTimePtr *time.Time // want "field TimesWithGroupedTimeImport.InvalidTimePtr pointer should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+}
@@ -0,0 +1,9 @@
+package a
+
+import "time"
+
+type TimesWithSingleTimeImport struct {
+	FirstTime time.Time // want "field TimesWithSingleTimeImport.FirstTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+
+	LastTime time.Time // want "field TimesWithSingleTimeImport.LastTime should not use time.Time. Use metav1.Time instead, which serializes consistently and handles null values."
+}
@@ -0,0 +1,27 @@
+package b
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Widget struct {
+	LastTransitionTime metav1.Time
+
+	LastUpdated metav1.Time // want "field Widget.LastUpdated uses a time type, its name should end with 'Time'"
+
+	Expiry *metav1.Time // want "field Widget.Expiry uses a time type, its name should end with 'Time'"
+
+	RenewTime metav1.MicroTime // want "field Widget.RenewTime should not use metav1.MicroTime outside of event types. Use metav1.Time instead."
+
+	Heartbeat *metav1.MicroTime // want "field Widget.Heartbeat should not use metav1.MicroTime outside of event types. Use metav1.Time instead." "field Widget.Heartbeat uses a time type, its name should end with 'Time'"
+}
+
+type Event struct {
+	EventTime metav1.MicroTime
+
+	Series *EventSeries
+}
+
+type EventSeries struct {
+	LastObservedTime metav1.MicroTime
+}
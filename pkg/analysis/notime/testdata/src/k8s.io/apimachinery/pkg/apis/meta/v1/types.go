@@ -0,0 +1,18 @@
+/*
+This is a copy of the minimum amount of the original file to be able to test the notime linter.
+*/
+
+package v1
+
+import "time"
+
+// Time is a wrapper around time.Time which supports correct
+// marshaling to YAML and JSON.
+type Time struct {
+	time.Time `protobuf:"-"`
+}
+
+// MicroTime is version of Time with microsecond level precision.
+type MicroTime struct {
+	time.Time `protobuf:"-"`
+}
@@ -21,6 +21,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/namingconventions"
 )
@@ -30,6 +31,10 @@ const (
 	doc  = "Suggest the usage of the term 'time' over 'timestamp'"
 )
 
+func init() {
+	checks.DefaultRegistry().Register(namingconventions.Check(name))
+}
+
 var errUnexpectedInitializerType = errors.New("expected namingconventions.Initializer() to be of type initializer.ConfigurableAnalyzerInitializer, but was not")
 
 func newAnalyzer() *analysis.Analyzer {
@@ -0,0 +1,360 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package objectreferences
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "objectreferences"
+
+	// clusterScope is the value of the scope argument of the resource marker for cluster-scoped objects.
+	clusterScope = "Cluster"
+
+	// corev1Path is the import path of the core v1 API package.
+	corev1Path = "k8s.io/api/core/v1"
+
+	// dnsSubdomainMaxLength is the maximum length of a DNS subdomain, as used for object names.
+	dnsSubdomainMaxLength = 253
+
+	// dnsLabelMaxLength is the maximum length of a DNS label, as used for namespaces.
+	dnsLabelMaxLength = 63
+
+	// k8sLongNameFormat is the declarative validation format for DNS subdomains.
+	k8sLongNameFormat = "k8s-long-name"
+
+	// k8sShortNameFormat is the declarative validation format for DNS labels.
+	k8sShortNameFormat = "k8s-short-name"
+)
+
+//nolint:gochecknoglobals
+var (
+	notStructCheck        = checks.New(name, "not-struct", config.SeverityWarning)
+	missingNameCheck      = checks.New(name, "missing-name", config.SeverityError)
+	apiGroupCheck         = checks.New(name, "api-group", config.SeverityWarning)
+	missingNamespaceCheck = checks.New(name, "missing-namespace", config.SeverityWarning)
+	nameValidationCheck   = checks.New(name, "name-validation", config.SeverityWarning)
+	objectReferenceCheck  = checks.New(name, "object-reference", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(notStructCheck, missingNameCheck, apiGroupCheck, missingNamespaceCheck, nameValidationCheck, objectReferenceCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderRootMarker,
+		markers.KubebuilderResourceMarker,
+		markers.KubebuilderPatternMarker,
+		markers.KubebuilderMaxLengthMarker,
+		markers.K8sMaxLengthMarker,
+		markers.K8sFormatMarker,
+	)
+}
+
+type analyzer struct {
+	checks                      checks.Config
+	clusterScopedReferenceTypes sets.Set[string]
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *ObjectReferencesConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &ObjectReferencesConfig{}
+	}
+
+	a := &analyzer{
+		checks:                      cfg.Checks,
+		clusterScopedReferenceTypes: sets.New(cfg.ClusterScopedReferenceTypes...),
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that reference fields use a struct containing the name, and where appropriate the namespace, API group and kind, of the referenced object, and that corev1.ObjectReference is not used.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	r := newReferenceChecker(pass, a.clusterScopedReferenceTypes)
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		typeMarkers := markersAccess.TypeMarkers(typeSpec)
+		if !utils.IsRootType(typeSpec, typeMarkers) || !isClusterScoped(typeMarkers) {
+			return
+		}
+
+		if obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName); ok {
+			r.markClusterScoped(obj.Type())
+		}
+	})
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		r.checkField(field, markersAccess, qualifiedFieldName)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// referenceChecker checks the reference fields within the package.
+type referenceChecker struct {
+	pass   *analysis.Pass
+	fields map[token.Pos]*ast.Field
+
+	// clusterScoped contains the named types reachable from cluster-scoped root objects.
+	clusterScoped sets.Set[*types.Named]
+
+	// clusterScopedReferenceTypes contains the names of reference types that refer to cluster-scoped objects.
+	clusterScopedReferenceTypes sets.Set[string]
+
+	// validated contains the name and namespace fields of reference types that have already been checked.
+	validated sets.Set[*types.Var]
+}
+
+func newReferenceChecker(pass *analysis.Pass, clusterScopedReferenceTypes sets.Set[string]) *referenceChecker {
+	return &referenceChecker{
+		pass:                        pass,
+		fields:                      utils.FieldsByPos(pass),
+		clusterScoped:               sets.New[*types.Named](),
+		clusterScopedReferenceTypes: clusterScopedReferenceTypes,
+		validated:                   sets.New[*types.Var](),
+	}
+}
+
+// markClusterScoped records the named types reachable from a cluster-scoped root object.
+func (r *referenceChecker) markClusterScoped(typ types.Type) {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Named:
+		if r.clusterScoped.Has(t) {
+			return
+		}
+
+		r.clusterScoped.Insert(t)
+		r.markClusterScoped(t.Underlying())
+	case *types.Pointer:
+		r.markClusterScoped(t.Elem())
+	case *types.Slice:
+		r.markClusterScoped(t.Elem())
+	case *types.Array:
+		r.markClusterScoped(t.Elem())
+	case *types.Map:
+		r.markClusterScoped(t.Elem())
+	case *types.Struct:
+		for field := range t.Fields() {
+			r.markClusterScoped(field.Type())
+		}
+	}
+}
+
+// checkField checks a field of the package.
+// Fields using corev1.ObjectReference are reported, and fields with a name ending in `Ref` or `Refs`
+// are checked to be a struct identifying the referenced object.
+func (r *referenceChecker) checkField(field *ast.Field, markersAccess markershelper.Markers, qualifiedFieldName string) {
+	typ := r.pass.TypesInfo.TypeOf(field.Type)
+	if typ == nil {
+		return
+	}
+
+	if isObjectReference(typ) {
+		objectReferenceCheck.Reportf(r.pass, field.Pos(), "field %s uses corev1.ObjectReference, which is discouraged as most of its fields do not apply to any one reference. Use a reference type specific to the referenced object instead", qualifiedFieldName)
+		return
+	}
+
+	fieldName := utils.FieldName(field)
+	if !strings.HasSuffix(fieldName, "Ref") && !strings.HasSuffix(fieldName, "Refs") {
+		return
+	}
+
+	refType := elemType(typ)
+
+	refStruct, ok := refType.Underlying().(*types.Struct)
+	if !ok {
+		notStructCheck.Reportf(r.pass, field.Pos(), "field %s is a reference, and should be a struct containing the name of the referenced object, instead of %s", qualifiedFieldName, types.ExprString(field.Type))
+		return
+	}
+
+	refTypeName := types.ExprString(elemExpr(field.Type))
+	refFields := utils.SerializedStructFields(refStruct)
+
+	if _, ok := refFields["name"]; !ok {
+		missingNameCheck.Reportf(r.pass, field.Pos(), "field %s is a reference, and its type %s should contain a name field", qualifiedFieldName, refTypeName)
+	}
+
+	r.checkAPIGroup(field, refFields, refTypeName, qualifiedFieldName)
+
+	if _, ok := refFields["namespace"]; !ok && r.isInClusterScopedType(field) && !r.refersToClusterScopedObject(refType) {
+		missingNamespaceCheck.Reportf(r.pass, field.Pos(), "field %s is a reference from a cluster-scoped object, and its type %s should contain a namespace field. References from cluster-scoped objects to namespaced objects must include the namespace of the referenced object", qualifiedFieldName, refTypeName)
+	}
+
+	r.checkNameValidation(refFields["name"], markersAccess, dnsSubdomainMaxLength, []string{k8sLongNameFormat, k8sShortNameFormat}, "DNS subdomain")
+	r.checkNameValidation(refFields["namespace"], markersAccess, dnsLabelMaxLength, []string{k8sShortNameFormat}, "DNS label")
+}
+
+// checkAPIGroup checks that the reference type identifies the kind of the referenced object by its API group,
+// and that the kind and API group are used together.
+func (r *referenceChecker) checkAPIGroup(field *ast.Field, refFields map[string]*types.Var, refTypeName, qualifiedFieldName string) {
+	_, hasAPIGroup := refFields["apiGroup"]
+	_, hasKind := refFields["kind"]
+	_, hasResource := refFields["resource"]
+
+	switch {
+	case refFields["apiVersion"] != nil:
+		apiGroupCheck.Reportf(r.pass, field.Pos(), "field %s is a reference, and its type %s should identify the referenced object with apiGroup, instead of apiVersion. References should not depend on the version of the referenced object", qualifiedFieldName, refTypeName)
+	case hasKind && !hasAPIGroup:
+		apiGroupCheck.Reportf(r.pass, field.Pos(), "field %s is a reference, and its type %s should contain an apiGroup field alongside kind, as the kind alone does not identify the referenced object", qualifiedFieldName, refTypeName)
+	case hasAPIGroup && !hasKind && !hasResource:
+		apiGroupCheck.Reportf(r.pass, field.Pos(), "field %s is a reference, and its type %s should contain a kind field alongside apiGroup, as the API group alone does not identify the referenced object", qualifiedFieldName, refTypeName)
+	}
+}
+
+// checkNameValidation checks that the name, or namespace, field of a reference type declared within the package
+// is validated as a DNS subdomain, or DNS label.
+// Each field is checked once, where it is declared, regardless of how many references use the type.
+func (r *referenceChecker) checkNameValidation(v *types.Var, markersAccess markershelper.Markers, maxLength int, formats []string, description string) {
+	if v == nil || v.Pkg() != r.pass.Pkg || r.validated.Has(v) {
+		return
+	}
+
+	r.validated.Insert(v)
+
+	field, ok := r.fields[v.Pos()]
+	if !ok {
+		return
+	}
+
+	fieldMarkers := utils.TypeAwareMarkerCollectionForField(r.pass, markersAccess, field)
+	if hasNameValidation(fieldMarkers, maxLength, formats) {
+		return
+	}
+
+	nameValidationCheck.Reportf(r.pass, field.Pos(), "field %s is part of a reference, and should be validated as a %s, with either +%s=%s, or both +%s and +%s of at most %d", utils.GetQualifiedFieldName(r.pass, field), description, markers.K8sFormatMarker, formats[0], markers.KubebuilderPatternMarker, markers.KubebuilderMaxLengthMarker, maxLength)
+}
+
+// isInClusterScopedType determines whether the field is declared within a type reachable from a cluster-scoped root object.
+func (r *referenceChecker) isInClusterScopedType(field *ast.Field) bool {
+	obj := r.pass.Pkg.Scope().Lookup(utils.GetStructName(r.pass, field))
+	if obj == nil {
+		return false
+	}
+
+	named, ok := obj.Type().(*types.Named)
+
+	return ok && r.clusterScoped.Has(named)
+}
+
+// refersToClusterScopedObject determines whether the reference type is configured as referring to cluster-scoped objects,
+// which have no namespace.
+func (r *referenceChecker) refersToClusterScopedObject(refType types.Type) bool {
+	named, ok := types.Unalias(refType).(*types.Named)
+
+	return ok && r.clusterScopedReferenceTypes.Has(named.Obj().Name())
+}
+
+// hasNameValidation determines whether the markers validate the value as a name,
+// either with one of the given formats, or with a pattern and a maximum length no greater than the given length.
+func hasNameValidation(fieldMarkers markershelper.MarkerSet, maxLength int, formats []string) bool {
+	for _, marker := range fieldMarkers.Get(markers.K8sFormatMarker) {
+		if slices.Contains(formats, marker.Payload.Value) {
+			return true
+		}
+	}
+
+	if !fieldMarkers.Has(markers.KubebuilderPatternMarker) {
+		return false
+	}
+
+	for _, marker := range slices.Concat(fieldMarkers.Get(markers.KubebuilderMaxLengthMarker), fieldMarkers.Get(markers.K8sMaxLengthMarker)) {
+		if length, err := strconv.Atoi(marker.Payload.Value); err == nil && length <= maxLength {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isClusterScoped determines whether the root object is cluster-scoped, marked with `+kubebuilder:resource:scope=Cluster`.
+func isClusterScoped(typeMarkers markershelper.MarkerSet) bool {
+	for _, marker := range typeMarkers.Get(markers.KubebuilderResourceMarker) {
+		if marker.Arguments["scope"] == clusterScope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isObjectReference determines whether the type is, or is a list of, corev1.ObjectReference.
+func isObjectReference(typ types.Type) bool {
+	named, ok := types.Unalias(elemType(typ)).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == corev1Path && named.Obj().Name() == "ObjectReference"
+}
+
+// elemType returns the type referenced by the field, looking through pointers, slices and arrays.
+func elemType(typ types.Type) types.Type {
+	for {
+		switch t := types.Unalias(typ).(type) {
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Array:
+			typ = t.Elem()
+		default:
+			return typ
+		}
+	}
+}
+
+// elemExpr returns the type expression referenced by the field, looking through pointers, slices and arrays.
+func elemExpr(expr ast.Expr) ast.Expr {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ArrayType:
+			expr = e.Elt
+		default:
+			return expr
+		}
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `objectreferences` linter checks the structure of fields that reference other objects.
+
+Fields with a name ending in `Ref` or `Refs` are references, and should be a struct, or a list of structs,
+identifying the referenced object. The linter checks that the reference type:
+- Contains a `name` field.
+- Identifies the kind of the referenced object with `apiGroup` and `kind` together, and does not use `apiVersion`,
+as references should not depend on the version of the referenced object. `apiGroup` may also be used alongside `resource`.
+- Contains a `namespace` field, when used within an object marked with `+kubebuilder:resource:scope=Cluster`,
+as references from cluster-scoped objects to namespaced objects must include the namespace.
+Reference types that refer to cluster-scoped objects, such as a `StorageClassReference`,
+can be configured with `clusterScopedReferenceTypes` so that they do not need a namespace.
+
+The `name` and `namespace` fields of reference types declared within the package must be validated,
+as a DNS subdomain and DNS label respectively. This is either with `+k8s:format=k8s-long-name` (`k8s-short-name` for namespaces),
+or with both a `+kubebuilder:validation:Pattern` and a `+kubebuilder:validation:MaxLength` of at most 253 (63 for namespaces).
+
+The linter also reports fields using `corev1.ObjectReference`, which upstream Kubernetes discourages,
+as most of its fields do not apply to any one reference.
+*/
+package objectreferences
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package objectreferences_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/objectreferences"
+)
+
+var _ = Describe("objectreferences initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      objectreferences.ObjectReferencesConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := objectreferences.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("objectreferences"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid ObjectReferencesConfig", testCase{
+				config:      objectreferences.ObjectReferencesConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid ObjectReferencesConfig: Checks: disable name-validation", testCase{
+				config: objectreferences.ObjectReferencesConfig{
+					Checks: checks.Config{
+						Disable: []string{"name-validation"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With a valid ObjectReferencesConfig: ClusterScopedReferenceTypes", testCase{
+				config: objectreferences.ObjectReferencesConfig{
+					ClusterScopedReferenceTypes: []string{"StorageClassReference", "LocalObjectReference"},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid ObjectReferencesConfig: ClusterScopedReferenceTypes: qualified type name", testCase{
+				config: objectreferences.ObjectReferencesConfig{
+					ClusterScopedReferenceTypes: []string{"StorageClassReference", "corev1.LocalObjectReference"},
+				},
+				expectedErr: "objectreferences.clusterScopedReferenceTypes[1]: Invalid value: \"corev1.LocalObjectReference\": must be the name of a type",
+			}),
+			Entry("With an invalid ObjectReferencesConfig: Checks: unknown check", testCase{
+				config: objectreferences.ObjectReferencesConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "objectreferences.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: api-group,missing-name,missing-namespace,name-validation,not-struct,object-reference",
+			}),
+		)
+	})
+})
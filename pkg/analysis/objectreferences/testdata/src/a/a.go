@@ -0,0 +1,214 @@
+package a
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type NamespacedObject struct {
+	Spec NamespacedObjectSpec `json:"spec"`
+}
+
+type NamespacedObjectSpec struct {
+	// secretRef is a reference to a secret in the same namespace.
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"`
+
+	// configMapRefs are references to config maps in the same namespace.
+	// +optional
+	ConfigMapRefs []SecretReference `json:"configMapRefs,omitempty"`
+
+	// localRef uses the core LocalObjectReference.
+	// +optional
+	LocalRef *corev1.LocalObjectReference `json:"localRef,omitempty"`
+
+	// typedRef uses the core TypedLocalObjectReference.
+	// +optional
+	TypedRef *corev1.TypedLocalObjectReference `json:"typedRef,omitempty"`
+
+	// stringRef is the name of the referenced object.
+	// +optional
+	StringRef string `json:"stringRef,omitempty"` // want "field NamespacedObjectSpec.StringRef is a reference, and should be a struct containing the name of the referenced object, instead of string"
+
+	// stringRefs are the names of the referenced objects.
+	// +optional
+	StringRefs []string `json:"stringRefs,omitempty"` // want "field NamespacedObjectSpec.StringRefs is a reference, and should be a struct containing the name of the referenced object, instead of \\[\\]string"
+
+	// uidRef references an object by UID.
+	// +optional
+	UIDRef *UIDReference `json:"uidRef,omitempty"` // want "field NamespacedObjectSpec.UIDRef is a reference, and its type UIDReference should contain a name field"
+
+	// versionedRef references an object by API version and kind.
+	// +optional
+	VersionedRef *VersionedReference `json:"versionedRef,omitempty"` // want "field NamespacedObjectSpec.VersionedRef is a reference, and its type VersionedReference should identify the referenced object with apiGroup, instead of apiVersion"
+
+	// kindRef references an object by kind, without an API group.
+	// +optional
+	KindRef *KindReference `json:"kindRef,omitempty"` // want "field NamespacedObjectSpec.KindRef is a reference, and its type KindReference should contain an apiGroup field alongside kind"
+
+	// groupRef references an object by API group, without a kind.
+	// +optional
+	GroupRef *GroupReference `json:"groupRef,omitempty"` // want "field NamespacedObjectSpec.GroupRef is a reference, and its type GroupReference should contain a kind field alongside apiGroup"
+
+	// resourceRef references an object by API group and resource.
+	// +optional
+	ResourceRef *ResourceReference `json:"resourceRef,omitempty"`
+
+	// typedRef2 references an object by API group and kind.
+	// +optional
+	TypedRef2 *TypedReference `json:"typedRef2,omitempty"`
+
+	// unvalidatedRef references an object with a name that is not validated.
+	// +optional
+	UnvalidatedRef *UnvalidatedReference `json:"unvalidatedRef,omitempty"`
+
+	// object uses the core ObjectReference.
+	// +optional
+	Object *corev1.ObjectReference `json:"object,omitempty"` // want "field NamespacedObjectSpec.Object uses corev1.ObjectReference, which is discouraged as most of its fields do not apply to any one reference. Use a reference type specific to the referenced object instead"
+
+	// objectRefs use the core ObjectReference.
+	// +optional
+	ObjectRefs []corev1.ObjectReference `json:"objectRefs,omitempty"` // want "field NamespacedObjectSpec.ObjectRefs uses corev1.ObjectReference"
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type ClusterObject struct {
+	Spec ClusterObjectSpec `json:"spec"`
+}
+
+type ClusterObjectSpec struct {
+	// secretRef is a reference to a secret, without a namespace.
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"` // want "field ClusterObjectSpec.SecretRef is a reference from a cluster-scoped object, and its type SecretReference should contain a namespace field. References from cluster-scoped objects to namespaced objects must include the namespace of the referenced object"
+
+	// localRef uses the core LocalObjectReference.
+	// +optional
+	LocalRef *corev1.LocalObjectReference `json:"localRef,omitempty"` // want "field ClusterObjectSpec.LocalRef is a reference from a cluster-scoped object, and its type corev1.LocalObjectReference should contain a namespace field"
+
+	// namespacedRef is a reference to a secret, with a namespace.
+	// +optional
+	NamespacedRef *NamespacedSecretReference `json:"namespacedRef,omitempty"`
+
+	// nested contains further references.
+	// +optional
+	Nested *NestedReferences `json:"nested,omitempty"`
+}
+
+type NestedReferences struct {
+	// inlineRef is a reference using an inline embedded name.
+	// +optional
+	InlineRef *InlineReference `json:"inlineRef,omitempty"` // want "field NestedReferences.InlineRef is a reference from a cluster-scoped object, and its type InlineReference should contain a namespace field"
+}
+
+type SecretReference struct {
+	// name is the name of the secret.
+	// +k8s:format=k8s-long-name
+	// +required
+	Name string `json:"name"`
+}
+
+type NamespacedSecretReference struct {
+	// name is the name of the secret.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+
+	// namespace is the namespace of the secret.
+	// +k8s:format=k8s-long-name
+	// +required
+	Namespace string `json:"namespace"` // want "field NamespacedSecretReference.Namespace is part of a reference, and should be validated as a DNS label, with either \\+k8s:format=k8s-short-name, or both \\+kubebuilder:validation:Pattern and \\+kubebuilder:validation:MaxLength of at most 63"
+}
+
+type UIDReference struct {
+	// uid is the UID of the referenced object.
+	// +required
+	UID string `json:"uid"`
+}
+
+type VersionedReference struct {
+	// apiVersion is the API version of the referenced object.
+	// +required
+	APIVersion string `json:"apiVersion"`
+
+	// kind is the kind of the referenced object.
+	// +required
+	Kind string `json:"kind"`
+
+	// name is the name of the referenced object.
+	// +required
+	Name ObjectName `json:"name"`
+}
+
+type KindReference struct {
+	// kind is the kind of the referenced object.
+	// +required
+	Kind string `json:"kind"`
+
+	// name is the name of the referenced object.
+	// +required
+	Name ObjectName `json:"name"`
+}
+
+type GroupReference struct {
+	// apiGroup is the API group of the referenced object.
+	// +required
+	APIGroup string `json:"apiGroup"`
+
+	// name is the name of the referenced object.
+	// +required
+	Name ObjectName `json:"name"`
+}
+
+type ResourceReference struct {
+	// apiGroup is the API group of the referenced object.
+	// +required
+	APIGroup string `json:"apiGroup"`
+
+	// resource is the resource of the referenced object.
+	// +required
+	Resource string `json:"resource"`
+
+	// name is the name of the referenced object.
+	// +required
+	Name ObjectName `json:"name"`
+}
+
+type TypedReference struct {
+	// apiGroup is the API group of the referenced object.
+	// +required
+	APIGroup string `json:"apiGroup"`
+
+	// kind is the kind of the referenced object.
+	// +required
+	Kind string `json:"kind"`
+
+	// name is the name of the referenced object.
+	// +required
+	Name ObjectName `json:"name"`
+}
+
+type UnvalidatedReference struct {
+	// name is the name of the referenced object.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=512
+	// +required
+	Name string `json:"name"` // want "field UnvalidatedReference.Name is part of a reference, and should be validated as a DNS subdomain, with either \\+k8s:format=k8s-long-name, or both \\+kubebuilder:validation:Pattern and \\+kubebuilder:validation:MaxLength of at most 253"
+}
+
+type InlineReference struct {
+	ObjectNameReference `json:",inline"`
+}
+
+type ObjectNameReference struct {
+	// name is the name of the referenced object.
+	// +required
+	Name ObjectName `json:"name"`
+}
+
+// ObjectName is the name of an object.
+// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+// +kubebuilder:validation:MaxLength=253
+type ObjectName string
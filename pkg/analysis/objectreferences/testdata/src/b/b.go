@@ -0,0 +1,43 @@
+package b
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+type ClusterObject struct {
+	Spec ClusterObjectSpec `json:"spec"`
+}
+
+type ClusterObjectSpec struct {
+	// storageClassRef is a reference to a cluster-scoped storage class.
+	// +optional
+	StorageClassRef *StorageClassReference `json:"storageClassRef,omitempty"`
+
+	// storageClassRefs are references to cluster-scoped storage classes.
+	// +optional
+	StorageClassRefs []StorageClassReference `json:"storageClassRefs,omitempty"`
+
+	// clusterRoleRef is a reference to a cluster-scoped cluster role, using the core LocalObjectReference.
+	// +optional
+	ClusterRoleRef *corev1.LocalObjectReference `json:"clusterRoleRef,omitempty"`
+
+	// secretRef is a reference to a secret, without a namespace.
+	// +optional
+	SecretRef *SecretReference `json:"secretRef,omitempty"` // want "field ClusterObjectSpec.SecretRef is a reference from a cluster-scoped object, and its type SecretReference should contain a namespace field"
+}
+
+type StorageClassReference struct {
+	// name is the name of the storage class.
+	// +k8s:format=k8s-long-name
+	// +required
+	Name string `json:"name"`
+}
+
+type SecretReference struct {
+	// name is the name of the secret.
+	// +k8s:format=k8s-long-name
+	// +required
+	Name string `json:"name"`
+}
@@ -0,0 +1,21 @@
+package v1
+
+type ObjectReference struct {
+	Kind            string `json:"kind,omitempty"`
+	Namespace       string `json:"namespace,omitempty"`
+	Name            string `json:"name,omitempty"`
+	UID             string `json:"uid,omitempty"`
+	APIVersion      string `json:"apiVersion,omitempty"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	FieldPath       string `json:"fieldPath,omitempty"`
+}
+
+type LocalObjectReference struct {
+	Name string `json:"name,omitempty"`
+}
+
+type TypedLocalObjectReference struct {
+	APIGroup *string `json:"apiGroup"`
+	Kind     string  `json:"kind"`
+	Name     string  `json:"name"`
+}
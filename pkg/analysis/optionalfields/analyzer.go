@@ -19,6 +19,7 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
@@ -33,6 +34,8 @@ const (
 )
 
 func init() {
+	checks.DefaultRegistry().Register(serialization.Checks(name)...)
+
 	markershelper.DefaultRegistry().Register(
 		markers.OptionalMarker,
 		markers.RequiredMarker,
@@ -50,6 +53,7 @@ func init() {
 
 type analyzer struct {
 	serializationCheck serialization.SerializationCheck
+	checks             checks.Config
 }
 
 // newAnalyzer creates a new analyzer.
@@ -61,6 +65,7 @@ func newAnalyzer(cfg *OptionalFieldsConfig) *analysis.Analyzer {
 	defaultConfig(cfg)
 
 	serializationCheck := serialization.New(&serialization.Config{
+		Linter: name,
 		Pointers: serialization.PointersConfig{
 			Policy:     serialization.PointersPolicy(cfg.Pointers.Policy),
 			Preference: serialization.PointersPreference(cfg.Pointers.Preference),
@@ -74,6 +79,7 @@ func newAnalyzer(cfg *OptionalFieldsConfig) *analysis.Analyzer {
 	})
 
 	a := &analyzer{
+		checks:             cfg.Checks,
 		serializationCheck: serializationCheck,
 	}
 
@@ -91,6 +97,8 @@ func newAnalyzer(cfg *OptionalFieldsConfig) *analysis.Analyzer {
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
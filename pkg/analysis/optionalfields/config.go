@@ -15,6 +15,8 @@ limitations under the License.
 */
 package optionalfields
 
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
 // OptionalFieldsConfig is the configuration for the optionalfields linter.
 type OptionalFieldsConfig struct {
 	// pointers is the policy for pointers in optional fields.
@@ -31,6 +33,11 @@ type OptionalFieldsConfig struct {
 	// This defines how the linter should handle optional fields, and whether they should have the omitzero tag or not.
 	// By default, all the struct fields will be expected to have the `omitzero` tag when their zero value is not an acceptable user choice.
 	OmitZero OptionalFieldsOmitZero `json:"omitzero"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `add-omitzero`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
 }
 
 // OptionalFieldsPointers is the configuration for pointers in optional fields.
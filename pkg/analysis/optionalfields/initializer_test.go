@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/optionalfields"
 )
@@ -135,6 +136,22 @@ var _ = Describe("optionalfields initializer", func() {
 				},
 				expectedErr: "optionalfields.omitEmpty.policy: Invalid value: \"invalid\": invalid value, must be one of \"Ignore\", \"Warn\", \"SuggestFix\" or omitted",
 			}),
+			Entry("With a valid OptionalFieldsConfig: Checks: Disable", testCase{
+				config: optionalfields.OptionalFieldsConfig{
+					Checks: checks.Config{
+						Disable: []string{"add-omitzero"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid OptionalFieldsConfig: Checks: unknown check", testCase{
+				config: optionalfields.OptionalFieldsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "optionalfields.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: add-omitempty,add-omitzero,add-pointer,invalid-marker,remove-omitzero,remove-pointer",
+			}),
 		)
 	})
 })
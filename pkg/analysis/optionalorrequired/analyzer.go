@@ -20,10 +20,12 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -31,7 +33,17 @@ const (
 	name = "optionalorrequired"
 )
 
+//nolint:gochecknoglobals
+var (
+	conflictingMarkersCheck = checks.New(name, "conflicting-markers", config.SeverityWarning)
+	secondaryMarkerCheck    = checks.New(name, "secondary-marker", config.SeverityWarning)
+	missingMarkerCheck      = checks.New(name, "missing-marker", config.SeverityWarning)
+	typeMarkerCheck         = checks.New(name, "type-marker", config.SeverityWarning)
+)
+
 func init() {
+	checks.DefaultRegistry().Register(conflictingMarkersCheck, secondaryMarkerCheck, missingMarkerCheck, typeMarkerCheck)
+
 	markershelper.DefaultRegistry().Register(
 		markers.OptionalMarker,
 		markers.RequiredMarker,
@@ -48,6 +60,8 @@ type analyzer struct {
 
 	primaryRequiredMarker   string
 	secondaryRequiredMarker string
+
+	checks checks.Config
 }
 
 // newAnalyzer creates a new analyzer with the given configuration.
@@ -58,7 +72,9 @@ func newAnalyzer(cfg *OptionalOrRequiredConfig) *analysis.Analyzer {
 
 	defaultConfig(cfg)
 
-	a := &analyzer{}
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
 
 	switch cfg.PreferredOptionalMarker {
 	case markers.OptionalMarker:
@@ -87,6 +103,8 @@ func newAnalyzer(cfg *OptionalOrRequiredConfig) *analysis.Analyzer {
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -133,25 +151,25 @@ func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, fieldMarker
 
 	switch {
 	case hasEitherOptional && hasEitherRequired:
-		pass.Reportf(field.Pos(), "%s must not be marked as both optional and required", prefix)
+		conflictingMarkersCheck.Reportf(pass, field.Pos(), "%s must not be marked as both optional and required", prefix)
 	case hasSecondaryOptional:
 		marker := fieldMarkers[a.secondaryOptionalMarker]
 		if hasBothOptional {
-			pass.Report(reportShouldRemoveSecondaryMarker(field, marker, a.primaryOptionalMarker, a.secondaryOptionalMarker, prefix))
+			secondaryMarkerCheck.Report(pass, reportShouldRemoveSecondaryMarker(field, marker, a.primaryOptionalMarker, a.secondaryOptionalMarker, prefix))
 		} else {
-			pass.Report(reportShouldReplaceSecondaryMarker(field, marker, a.primaryOptionalMarker, a.secondaryOptionalMarker, prefix))
+			secondaryMarkerCheck.Report(pass, reportShouldReplaceSecondaryMarker(field, marker, a.primaryOptionalMarker, a.secondaryOptionalMarker, prefix))
 		}
 	case hasSecondaryRequired:
 		marker := fieldMarkers[a.secondaryRequiredMarker]
 		if hasBothRequired {
-			pass.Report(reportShouldRemoveSecondaryMarker(field, marker, a.primaryRequiredMarker, a.secondaryRequiredMarker, prefix))
+			secondaryMarkerCheck.Report(pass, reportShouldRemoveSecondaryMarker(field, marker, a.primaryRequiredMarker, a.secondaryRequiredMarker, prefix))
 		} else {
-			pass.Report(reportShouldReplaceSecondaryMarker(field, marker, a.primaryRequiredMarker, a.secondaryRequiredMarker, prefix))
+			secondaryMarkerCheck.Report(pass, reportShouldReplaceSecondaryMarker(field, marker, a.primaryRequiredMarker, a.secondaryRequiredMarker, prefix))
 		}
 	case hasPrimaryOptional || hasPrimaryRequired:
 		// This is the correct state.
 	default:
-		pass.Reportf(field.Pos(), "%s must be marked as %s or %s", prefix, a.primaryOptionalMarker, a.primaryRequiredMarker)
+		missingMarkerCheck.Reportf(pass, field.Pos(), "%s must be marked as %s or %s", prefix, a.primaryOptionalMarker, a.primaryRequiredMarker)
 	}
 }
 
@@ -160,15 +178,15 @@ func (a *analyzer) checkK8sMarkers(pass *analysis.Pass, field *ast.Field, fieldM
 	hasK8sRequired := fieldMarkers.Has(markers.K8sRequiredMarker)
 
 	if hasK8sOptional && hasK8sRequired {
-		pass.Reportf(field.Pos(), "%s must not be marked as both %s and %s", prefix, markers.K8sOptionalMarker, markers.K8sRequiredMarker)
+		conflictingMarkersCheck.Reportf(pass, field.Pos(), "%s must not be marked as both %s and %s", prefix, markers.K8sOptionalMarker, markers.K8sRequiredMarker)
 	}
 
 	if hasK8sOptional && hasEitherRequired {
-		pass.Reportf(field.Pos(), "%s must not be marked as both %s and %s", prefix, markers.K8sOptionalMarker, markers.RequiredMarker)
+		conflictingMarkersCheck.Reportf(pass, field.Pos(), "%s must not be marked as both %s and %s", prefix, markers.K8sOptionalMarker, markers.RequiredMarker)
 	}
 
 	if hasK8sRequired && hasEitherOptional {
-		pass.Reportf(field.Pos(), "%s must not be marked as both %s and %s", prefix, markers.OptionalMarker, markers.K8sRequiredMarker)
+		conflictingMarkersCheck.Reportf(pass, field.Pos(), "%s must not be marked as both %s and %s", prefix, markers.OptionalMarker, markers.K8sRequiredMarker)
 	}
 }
 
@@ -235,7 +253,7 @@ func (a *analyzer) checkTypeSpec(pass *analysis.Pass, typeSpec *ast.TypeSpec, ma
 	for _, marker := range set.UnsortedList() {
 		switch marker.Identifier {
 		case a.primaryOptionalMarker, a.secondaryOptionalMarker, a.primaryRequiredMarker, a.secondaryRequiredMarker, markers.K8sOptionalMarker, markers.K8sRequiredMarker:
-			pass.Report(analysis.Diagnostic{
+			typeMarkerCheck.Report(pass, analysis.Diagnostic{
 				Pos:     typeSpec.Pos(),
 				Message: fmt.Sprintf("type %s should not be marked as %s", name, marker.String()),
 				SuggestedFixes: []analysis.SuggestedFix{
@@ -15,15 +15,39 @@ limitations under the License.
 */
 package optionalorrequired
 
+import (
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
 // OptionalOrRequiredConfig contains configuration for the optionalorrequired linter.
 type OptionalOrRequiredConfig struct {
 	// preferredOptionalMarker is the preferred marker to use for optional fields.
-	// If this field is not set, the default value is "optional".
+	// If this field is not set, it is inherited from `preferences.optionalMarker` unless it is "Declarative", or the default value is "optional".
 	// Valid values are "optional" and "kubebuilder:validation:Optional".
 	PreferredOptionalMarker string `json:"preferredOptionalMarker"`
 
 	// preferredRequiredMarker is the preferred marker to use for required fields.
-	// If this field is not set, the default value is "required".
+	// If this field is not set, it is inherited from `preferences.requiredMarker` unless it is "Declarative", or the default value is "required".
 	// Valid values are "required" and "kubebuilder:validation:Required".
 	PreferredRequiredMarker string `json:"preferredRequiredMarker"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `missing-marker`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
+
+// supportedMarkerStyles are the marker styles that may be inherited from the shared preferences.
+// Declarative validation markers are not supported.
+//
+//nolint:gochecknoglobals
+var supportedMarkerStyles = []config.MarkerStyle{config.MarkerStyleStandard, config.MarkerStyleKubebuilder}
+
+// MarkerPreferences returns the settings that may be inherited from the shared preferences.
+func (c *OptionalOrRequiredConfig) MarkerPreferences() []config.MarkerPreference {
+	return []config.MarkerPreference{
+		{Kind: config.MarkerKindOptional, Field: "preferredOptionalMarker", Value: &c.PreferredOptionalMarker, Styles: supportedMarkerStyles},
+		{Kind: config.MarkerKindRequired, Field: "preferredRequiredMarker", Value: &c.PreferredRequiredMarker, Styles: supportedMarkerStyles},
+	}
 }
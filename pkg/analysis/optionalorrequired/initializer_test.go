@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/optionalorrequired"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
@@ -70,6 +71,14 @@ var _ = Describe("optionalorrequired initializer", func() {
 				},
 				expectedErr: "optionalorrequired.preferredRequiredMarker: Invalid value: \"invalid\": invalid value, must be one of \"required\", \"kubebuilder:validation:Required\" or omitted",
 			}),
+			Entry("With an unknown check", testCase{
+				config: optionalorrequired.OptionalOrRequiredConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "optionalorrequired.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: conflicting-markers,missing-marker,secondary-marker,type-marker",
+			}),
 		)
 	})
 })
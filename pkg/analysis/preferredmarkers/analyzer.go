@@ -23,14 +23,23 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 const name = "preferredmarkers"
 
+//nolint:gochecknoglobals
+var preferredMarkerCheck = checks.New(name, "preferred-marker", config.SeverityWarning)
+
+func init() {
+	checks.DefaultRegistry().Register(preferredMarkerCheck)
+}
+
 type analyzer struct {
 	// equivalentToPreferred maps equivalent marker identifiers to their preferred identifiers
 	equivalentToPreferred map[string]string
@@ -224,7 +233,7 @@ func reportMarkers(pass *analysis.Pass, marks []markers.Marker, preferredIdentif
 		fixMessage = fmt.Sprintf("replace with %q", preferredIdentifier)
 	}
 
-	pass.Report(analysis.Diagnostic{
+	preferredMarkerCheck.Report(pass, analysis.Diagnostic{
 		Pos:     pos,
 		Message: message,
 		SuggestedFixes: []analysis.SuggestedFix{
@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package printcolumns
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "printcolumns"
+
+	// metav1Path is the import path of the apimachinery meta v1 package, containing the Time types.
+	metav1Path = "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	columnTypeString  = "string"
+	columnTypeInteger = "integer"
+	columnTypeNumber  = "number"
+	columnTypeBoolean = "boolean"
+	columnTypeDate    = "date"
+)
+
+//nolint:gochecknoglobals
+var (
+	jsonPathCheck = checks.New(name, "json-path", config.SeverityError)
+	typeCheck     = checks.New(name, "type", config.SeverityError)
+	priorityCheck = checks.New(name, "priority", config.SeverityError)
+	formatCheck   = checks.New(name, "format", config.SeverityError)
+
+	// columnTypes are the valid types of printer columns.
+	columnTypes = []string{columnTypeString, columnTypeInteger, columnTypeNumber, columnTypeBoolean, columnTypeDate}
+
+	// columnFormats are the valid formats of printer columns.
+	columnFormats = []string{"int32", "int64", "float", "double", "byte", "date", "date-time", "password"}
+
+	// externalColumnTypes are the column types valid for types from other packages, keyed by their qualified name.
+	externalColumnTypes = map[string][]string{
+		metav1Path + ".Time":                              {columnTypeDate, columnTypeString},
+		metav1Path + ".MicroTime":                         {columnTypeDate, columnTypeString},
+		metav1Path + ".Duration":                          {columnTypeString},
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":   {columnTypeString},
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString": {columnTypeString},
+	}
+)
+
+func init() {
+	checks.DefaultRegistry().Register(jsonPathCheck, typeCheck, priorityCheck, formatCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderPrintColumnMarker,
+	)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *PrintColumnsConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &PrintColumnsConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that the JSONPath of each kubebuilder:printcolumn marker resolves to a field of the type, and that the type, priority and format of the column are valid.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+		if !ok {
+			return
+		}
+
+		for _, marker := range markersAccess.TypeMarkers(typeSpec).Get(markers.KubebuilderPrintColumnMarker) {
+			checkPrintColumn(pass, typeSpec, obj.Type(), marker)
+		}
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkPrintColumn checks that the JSONPath of the printer column resolves to a field of the type,
+// that the type of the column matches the type of the field, and that the priority and format are valid.
+func checkPrintColumn(pass *analysis.Pass, typeSpec *ast.TypeSpec, typ types.Type, marker markershelper.Marker) {
+	columnName := unquote(marker.Arguments["name"])
+	columnType := unquote(marker.Arguments["type"])
+	jsonPath := unquote(marker.Arguments["JSONPath"])
+
+	if !slices.Contains(columnTypes, columnType) {
+		typeCheck.Reportf(pass, typeSpec.Pos(), "type %s has printcolumn %q with invalid type %q, must be one of: %s", typeSpec.Name.Name, columnName, columnType, strings.Join(columnTypes, ", "))
+	}
+
+	checkPriority(pass, typeSpec, marker, columnName)
+	checkFormat(pass, typeSpec, marker, columnName)
+
+	segments, err := utils.ParseJSONPath(jsonPath)
+	if err != nil {
+		jsonPathCheck.Reportf(pass, typeSpec.Pos(), "type %s has printcolumn %q with invalid JSONPath %q: the JSONPath %v", typeSpec.Name.Name, columnName, jsonPath, err)
+		return
+	}
+
+	fieldType, err := utils.ResolveJSONPath(typ, segments)
+	if err != nil {
+		jsonPathCheck.Reportf(pass, typeSpec.Pos(), "type %s has printcolumn %q with JSONPath %q that does not resolve to a field: %v", typeSpec.Name.Name, columnName, jsonPath, err)
+		return
+	}
+
+	if slices.Contains(columnTypes, columnType) && !slices.Contains(validColumnTypes(fieldType), columnType) {
+		typeCheck.Reportf(pass, typeSpec.Pos(), "type %s has printcolumn %q with type %q, but JSONPath %q resolves to a value of type %s", typeSpec.Name.Name, columnName, columnType, jsonPath, types.TypeString(fieldType, qualifier(pass)))
+	}
+}
+
+// checkPriority checks that the priority of the printer column, when set, is a non-negative integer.
+func checkPriority(pass *analysis.Pass, typeSpec *ast.TypeSpec, marker markershelper.Marker, columnName string) {
+	priority, ok := marker.Arguments["priority"]
+	if !ok {
+		return
+	}
+
+	if value, err := strconv.Atoi(unquote(priority)); err != nil || value < 0 {
+		priorityCheck.Reportf(pass, typeSpec.Pos(), "type %s has printcolumn %q with invalid priority %s, must be a non-negative integer. Columns with priority 0 are shown in the standard view, and columns with a greater priority only in the wide view", typeSpec.Name.Name, columnName, priority)
+	}
+}
+
+// checkFormat checks that the format of the printer column, when set, is a valid format.
+func checkFormat(pass *analysis.Pass, typeSpec *ast.TypeSpec, marker markershelper.Marker, columnName string) {
+	format, ok := marker.Arguments["format"]
+	if !ok {
+		return
+	}
+
+	if !slices.Contains(columnFormats, unquote(format)) {
+		formatCheck.Reportf(pass, typeSpec.Pos(), "type %s has printcolumn %q with invalid format %s, must be one of: %s", typeSpec.Name.Name, columnName, format, strings.Join(columnFormats, ", "))
+	}
+}
+
+// validColumnTypes returns the printer column types that are valid for a value of the given type.
+func validColumnTypes(typ types.Type) []string {
+	typ = utils.DerefType(typ)
+
+	if named, ok := types.Unalias(typ).(*types.Named); ok && named.Obj().Pkg() != nil {
+		if columnTypes, ok := externalColumnTypes[named.Obj().Pkg().Path()+"."+named.Obj().Name()]; ok {
+			return columnTypes
+		}
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return nil
+	}
+
+	switch info := basic.Info(); {
+	case info&types.IsString != 0:
+		return []string{columnTypeString, columnTypeDate}
+	case info&types.IsInteger != 0:
+		return []string{columnTypeInteger, columnTypeNumber}
+	case info&types.IsFloat != 0:
+		return []string{columnTypeNumber}
+	case info&types.IsBoolean != 0:
+		return []string{columnTypeBoolean}
+	default:
+		return nil
+	}
+}
+
+// qualifier qualifies types from other packages by the name of their package.
+func qualifier(pass *analysis.Pass) types.Qualifier {
+	return func(pkg *types.Package) string {
+		if pkg == pass.Pkg {
+			return ""
+		}
+
+		return pkg.Name()
+	}
+}
+
+// unquote removes the quotes surrounding a marker argument.
+func unquote(value string) string {
+	return strings.Trim(value, "\"`")
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `printcolumns` linter checks the `+kubebuilder:printcolumn` markers of types.
+
+Printer columns are shown by `kubectl get`, with the value at the JSONPath of the column.
+When the JSONPath does not resolve to a field, the column is silently empty.
+The linter resolves the JSONPath of each column against the serialized fields of the type,
+including inline embedded structs, the elements of lists, such as `.spec.containers[*].image`,
+and the values of maps, such as `.metadata.labels.app`.
+
+The linter also checks that:
+- The type of the column is one of `string`, `integer`, `number`, `boolean` or `date`, and matches the type of the field.
+- The priority of the column, when set, is a non-negative integer.
+- The format of the column, when set, is one of `int32`, `int64`, `float`, `double`, `byte`, `date`, `date-time` or `password`.
+*/
+package printcolumns
@@ -0,0 +1,66 @@
+package a
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=".spec.replicas"
+// +kubebuilder:printcolumn:name="Ratio",type=number,JSONPath=".spec.replicas",priority=1
+// +kubebuilder:printcolumn:name="Image",type=string,JSONPath=".spec.containers[*].image"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Paused",type=boolean,JSONPath=".spec.paused"
+// +kubebuilder:printcolumn:name="Inline",type=string,JSONPath=".spec.inlineName"
+// +kubebuilder:printcolumn:name="App",type=string,JSONPath=".metadata.labels.app"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=".spec.version",format=password
+type ValidColumns struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Spec   `json:"spec,omitempty"`
+	Status Status `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Typo",type=integer,JSONPath=".spec.replica"
+// +kubebuilder:printcolumn:name="NoDot",type=integer,JSONPath="spec.replicas"
+// +kubebuilder:printcolumn:name="NotList",type=string,JSONPath=".spec.version[0]"
+// +kubebuilder:printcolumn:name="NotObject",type=string,JSONPath=".spec.version.major"
+// +kubebuilder:printcolumn:name="Unclosed",type=string,JSONPath=".spec.containers[0.image"
+// +kubebuilder:printcolumn:name="WrongType",type=integer,JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="Object",type=string,JSONPath=".spec.containers[0]"
+// +kubebuilder:printcolumn:name="UnknownType",type=int,JSONPath=".spec.replicas"
+// +kubebuilder:printcolumn:name="NegativePriority",type=integer,JSONPath=".spec.replicas",priority=-1
+// +kubebuilder:printcolumn:name="BadFormat",type=string,JSONPath=".spec.version",format=uuid
+type InvalidColumns struct { // want "type InvalidColumns has printcolumn \"Typo\" with JSONPath \".spec.replica\" that does not resolve to a field: .spec has no field \"replica\"" "type InvalidColumns has printcolumn \"NoDot\" with invalid JSONPath \"spec.replicas\": the JSONPath must start with a '.'" "type InvalidColumns has printcolumn \"NotList\" with JSONPath \".spec.version\\[0\\]\" that does not resolve to a field: .spec.version is not a list, and so cannot be subscripted" "type InvalidColumns has printcolumn \"NotObject\" with JSONPath \".spec.version.major\" that does not resolve to a field: .spec.version is not an object, and so has no field \"major\"" "type InvalidColumns has printcolumn \"Unclosed\" with invalid JSONPath \".spec.containers\\[0.image\": the JSONPath has an unclosed '\\['" "type InvalidColumns has printcolumn \"WrongType\" with type \"integer\", but JSONPath \".spec.version\" resolves to a value of type string" "type InvalidColumns has printcolumn \"Object\" with type \"string\", but JSONPath \".spec.containers\\[0\\]\" resolves to a value of type Container" "type InvalidColumns has printcolumn \"UnknownType\" with invalid type \"int\", must be one of: string, integer, number, boolean, date" "type InvalidColumns has printcolumn \"NegativePriority\" with invalid priority -1, must be a non-negative integer. Columns with priority 0 are shown in the standard view, and columns with a greater priority only in the wide view" "type InvalidColumns has printcolumn \"BadFormat\" with invalid format uuid, must be one of: int32, int64, float, double, byte, date, date-time, password"
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Spec   `json:"spec,omitempty"`
+	Status Status `json:"status,omitempty"`
+}
+
+type Spec struct {
+	InlineSpec `json:",inline"`
+
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	Paused bool `json:"paused,omitempty"`
+
+	Version string `json:"version,omitempty"`
+
+	Containers []Container `json:"containers,omitempty"`
+}
+
+type InlineSpec struct {
+	InlineName string `json:"inlineName,omitempty"`
+}
+
+type Container struct {
+	Image string `json:"image,omitempty"`
+}
+
+type Status struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
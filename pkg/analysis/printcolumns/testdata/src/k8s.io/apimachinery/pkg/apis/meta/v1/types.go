@@ -0,0 +1,33 @@
+// This is a copy of the minimum amount of the original file to be able to test the printcolumns linter.
+package v1
+
+// TypeMeta describes an individual object in an API response or request
+// with strings representing the type of the object and its API schema version.
+type TypeMeta struct {
+	Kind string `json:"kind,omitempty"`
+
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// ObjectMeta is metadata that all persisted resources must have.
+type ObjectMeta struct {
+	Name string `json:"name,omitempty"`
+
+	Namespace string `json:"namespace,omitempty"`
+
+	Labels map[string]string `json:"labels,omitempty"`
+
+	CreationTimestamp Time `json:"creationTimestamp,omitempty"`
+}
+
+// Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON.
+type Time struct{}
+
+// Condition contains details for one aspect of the current state of this API Resource.
+type Condition struct {
+	Type string `json:"type"`
+
+	Status string `json:"status"`
+
+	LastTransitionTime Time `json:"lastTransitionTime"`
+}
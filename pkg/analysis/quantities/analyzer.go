@@ -0,0 +1,255 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package quantities
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "quantities"
+
+	resourcePkgPath = "k8s.io/apimachinery/pkg/api/resource"
+	intstrPkgPath   = "k8s.io/apimachinery/pkg/util/intstr"
+
+	// byteUnitSuffixExpr matches names with a byte unit suffix.
+	byteUnitSuffixExpr = `(Bytes|KB|MB|GB|TB|KiB|MiB|GiB|TiB)$`
+)
+
+//nolint:gochecknoglobals
+var (
+	quantityTypeCheck       = checks.New(name, "quantity-type", config.SeverityInfo)
+	intOrStringCheck        = checks.New(name, "int-or-string-validation", config.SeverityWarning)
+	quantityValidationCheck = checks.New(name, "quantity-validation", config.SeverityWarning)
+
+	// defaultQuantityFieldNames match the names of fields that commonly represent resource quantities.
+	defaultQuantityFieldNames = []string{
+		`^(CPU|Cpu|Memory|Storage|EphemeralStorage)$`,
+		`Size$`,
+		byteUnitSuffixExpr,
+	}
+
+	// byteUnitSuffixRegex matches the names of integer fields that carry their unit,
+	// as required by the unitsuffix linter, and so are not reported.
+	byteUnitSuffixRegex = regexp.MustCompile(byteUnitSuffixExpr)
+
+	// selfRegex matches references to self within a CEL rule.
+	selfRegex = regexp.MustCompile(`\bself\b`)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(quantityTypeCheck, intOrStringCheck, quantityValidationCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderXIntOrStringMarker,
+		markers.KubebuilderPatternMarker,
+		markers.KubebuilderXValidationMarker,
+	)
+}
+
+type analyzer struct {
+	quantityFieldNames []*regexp.Regexp
+	checks             checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *QuantitiesConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &QuantitiesConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	for _, expr := range cfg.QuantityFieldNames {
+		// The expressions have already been validated.
+		a.quantityFieldNames = append(a.quantityFieldNames, regexp.MustCompile(expr))
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that resource amounts use resource.Quantity, that intstr.IntOrString is validated, and that quantities are parsed with quantity() in CEL rules.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer, extractjsontags.Analyzer},
+	}
+}
+
+func defaultConfig(cfg *QuantitiesConfig) {
+	if len(cfg.QuantityFieldNames) == 0 {
+		cfg.QuantityFieldNames = defaultQuantityFieldNames
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	jsonTags, ok := pass.ResultOf[extractjsontags.Analyzer].(extractjsontags.StructFieldTags)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetJSONTags
+	}
+
+	inspect.InspectFields(func(field *ast.Field, _ extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		a.checkQuantityType(pass, field, qualifiedFieldName)
+		checkIntOrString(pass, field, markersAccess, qualifiedFieldName)
+		checkFieldQuantityValidation(pass, field, markersAccess, qualifiedFieldName)
+	})
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		checkTypeQuantityValidation(pass, typeSpec, markersAccess, jsonTags)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+// checkQuantityType reports string and integer fields whose names suggest they represent a resource quantity.
+func (a *analyzer) checkQuantityType(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string) {
+	fieldName := utils.FieldName(field)
+	if fieldName == "" || !a.isQuantityFieldName(fieldName) {
+		return
+	}
+
+	_, fieldType := utils.IsStarExpr(field.Type)
+
+	typ := pass.TypesInfo.TypeOf(fieldType)
+	if typ == nil {
+		return
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsString) == 0 {
+		return
+	}
+
+	// Integer sizes with a byte unit suffix, e.g. `CacheSizeBytes`, follow the naming required by the unitsuffix linter.
+	if basic.Info()&types.IsInteger != 0 && byteUnitSuffixRegex.MatchString(fieldName) {
+		return
+	}
+
+	quantityTypeCheck.Reportf(pass, field.Pos(), "field %s appears to represent a resource quantity and should use resource.Quantity instead of %s", qualifiedFieldName, types.ExprString(field.Type))
+}
+
+// isQuantityFieldName determines whether the field name matches any of the quantity field name expressions.
+func (a *analyzer) isQuantityFieldName(fieldName string) bool {
+	for _, re := range a.quantityFieldNames {
+		if re.MatchString(fieldName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkIntOrString reports intstr.IntOrString fields that have no validation restricting the allowed values.
+func checkIntOrString(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, qualifiedFieldName string) {
+	_, fieldType := utils.IsStarExpr(field.Type)
+	if !utils.IsNamedType(pass, fieldType, intstrPkgPath, "IntOrString") {
+		return
+	}
+
+	fieldMarkers := utils.TypeAwareMarkerCollectionForField(pass, markersAccess, field)
+
+	if fieldMarkers.Has(markers.KubebuilderXIntOrStringMarker) || fieldMarkers.Has(markers.KubebuilderPatternMarker) || fieldMarkers.Has(markers.KubebuilderXValidationMarker) {
+		return
+	}
+
+	intOrStringCheck.Reportf(pass, field.Pos(), "field %s uses intstr.IntOrString without validation. Add an XIntOrString, Pattern or XValidation marker to restrict the allowed values, or use a single type instead", qualifiedFieldName)
+}
+
+// checkFieldQuantityValidation reports CEL rules on quantity fields that use the quantity without parsing it with quantity().
+func checkFieldQuantityValidation(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, qualifiedFieldName string) {
+	_, fieldType := utils.IsStarExpr(field.Type)
+	if !utils.IsNamedType(pass, fieldType, resourcePkgPath, "Quantity") {
+		return
+	}
+
+	for _, marker := range markersAccess.FieldMarkers(field)[markers.KubebuilderXValidationMarker] {
+		if !usesUnparsedQuantity(marker.Arguments["rule"], selfRegex) {
+			continue
+		}
+
+		quantityValidationCheck.Reportf(pass, field.Pos(), "field %s has an XValidation rule that uses the quantity without quantity(). Quantities must be parsed with quantity() to be compared", qualifiedFieldName)
+	}
+}
+
+// checkTypeQuantityValidation reports CEL rules on struct types that use a quantity field without parsing it with quantity().
+func checkTypeQuantityValidation(pass *analysis.Pass, typeSpec *ast.TypeSpec, markersAccess markershelper.Markers, jsonTags extractjsontags.StructFieldTags) {
+	sTyp, ok := typeSpec.Type.(*ast.StructType)
+	if !ok || sTyp.Fields == nil {
+		return
+	}
+
+	rules := markersAccess.TypeMarkers(typeSpec)[markers.KubebuilderXValidationMarker]
+	if len(rules) == 0 {
+		return
+	}
+
+	for _, field := range sTyp.Fields.List {
+		_, fieldType := utils.IsStarExpr(field.Type)
+		if !utils.IsNamedType(pass, fieldType, resourcePkgPath, "Quantity") {
+			continue
+		}
+
+		tagInfo := jsonTags.FieldTags(field)
+		if tagInfo.Name == "" || tagInfo.Ignored {
+			continue
+		}
+
+		fieldRegex := regexp.MustCompile(`\bself\.` + regexp.QuoteMeta(tagInfo.Name) + `\b`)
+
+		for _, marker := range rules {
+			if !usesUnparsedQuantity(marker.Arguments["rule"], fieldRegex) {
+				continue
+			}
+
+			quantityValidationCheck.Reportf(pass, typeSpec.Pos(), "type %s has an XValidation rule that uses quantity field %s without quantity(). Quantities must be parsed with quantity() to be compared", typeSpec.Name.Name, tagInfo.Name)
+		}
+	}
+}
+
+// usesUnparsedQuantity determines whether any reference within the rule is not
+// passed directly to quantity(), isQuantity() or has().
+func usesUnparsedQuantity(rule string, reference *regexp.Regexp) bool {
+	for _, loc := range reference.FindAllStringIndex(rule, -1) {
+		preceding := strings.TrimRight(rule[:loc[0]], " ")
+
+		if !strings.HasSuffix(preceding, "quantity(") && !strings.HasSuffix(preceding, "isQuantity(") && !strings.HasSuffix(preceding, "has(") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package quantities
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// QuantitiesConfig contains configuration for the quantities linter.
+type QuantitiesConfig struct {
+	// quantityFieldNames is a list of regular expressions matched against the Go names of fields.
+	// Fields with a string or integer type whose name matches any of the expressions are expected
+	// to use resource.Quantity instead.
+	// When omitted, fields named `CPU`, `Memory`, `Storage` or `EphemeralStorage`,
+	// and fields with the suffix `Size` or a byte unit suffix, e.g. `Bytes` or `MiB`, are matched.
+	// Integer fields with a byte unit suffix are not reported, as they follow the naming required by the unitsuffix linter.
+	QuantityFieldNames []string `json:"quantityFieldNames"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `quantity-type`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
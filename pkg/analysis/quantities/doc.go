@@ -0,0 +1,33 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `quantities` linter checks that resource amounts within the API types are represented using `resource.Quantity`.
+
+Fields whose names suggest that they represent an amount of a resource, e.g. `CPU`, `Memory`, `Storage`, `VolumeSize`
+or `CacheMiB`, and that are declared as a string or an integer, are reported, so that the amount can be expressed
+and compared consistently with other resource amounts in Kubernetes.
+The names that are matched can be configured with a list of regular expressions.
+Integer fields whose names end with a byte unit suffix, e.g. `VolumeSizeBytes`, are not reported,
+as they follow the naming required by the `unitsuffix` linter.
+
+The linter also checks that fields using `intstr.IntOrString` are validated with an `XIntOrString`, `Pattern`
+or `XValidation` marker, restricting the values that they accept.
+
+As quantities are serialized as strings, CEL validation rules must parse a quantity with `quantity()` before comparing it.
+The linter checks that `XValidation` rules on a quantity field, and rules on a struct that refer to its quantity fields,
+only use the quantity within `quantity()`, `isQuantity()` or `has()`.
+*/
+package quantities
@@ -0,0 +1,85 @@
+package a
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type Resources struct {
+	ValidCPU resource.Quantity `json:"validCPU"`
+
+	ValidMemory *resource.Quantity `json:"validMemory,omitempty"`
+
+	CPU string `json:"cpu"` // want "field Resources.CPU appears to represent a resource quantity and should use resource.Quantity instead of string"
+
+	Memory int64 `json:"memory"` // want "field Resources.Memory appears to represent a resource quantity and should use resource.Quantity instead of int64"
+
+	Storage *string `json:"storage,omitempty"` // want "field Resources.Storage appears to represent a resource quantity and should use resource.Quantity instead of \\*string"
+
+	VolumeSize int32 `json:"volumeSize"` // want "field Resources.VolumeSize appears to represent a resource quantity and should use resource.Quantity instead of int32"
+
+	CacheMiB string `json:"cacheMiB"` // want "field Resources.CacheMiB appears to represent a resource quantity and should use resource.Quantity instead of string"
+
+	// Integer sizes with a byte unit suffix follow the naming required by the unitsuffix linter, and so are not reported.
+	VolumeSizeBytes int64  `json:"volumeSizeBytes"`
+	CacheSizeMiB    *int32 `json:"cacheSizeMiB,omitempty"`
+
+	BufferBytes StringAlias `json:"bufferBytes"` // want "field Resources.BufferBytes appears to represent a resource quantity and should use resource.Quantity instead of StringAlias"
+
+	// Names that do not match are not reported.
+	Replicas int32 `json:"replicas"`
+
+	// Types other than strings and integers are not reported.
+	MemoryEnabled bool    `json:"memoryEnabled"`
+	Sizes         []int32 `json:"sizes"`
+}
+
+type StringAlias string
+
+type Ports struct {
+	Port intstr.IntOrString `json:"port"` // want "field Ports.Port uses intstr.IntOrString without validation. Add an XIntOrString, Pattern or XValidation marker to restrict the allowed values, or use a single type instead"
+
+	PortPtr *intstr.IntOrString `json:"portPtr,omitempty"` // want "field Ports.PortPtr uses intstr.IntOrString without validation. Add an XIntOrString, Pattern or XValidation marker to restrict the allowed values, or use a single type instead"
+
+	// +kubebuilder:validation:XIntOrString
+	ValidXIntOrString intstr.IntOrString `json:"validXIntOrString"`
+
+	// +kubebuilder:validation:Pattern=`^[0-9]+%?$`
+	ValidPattern intstr.IntOrString `json:"validPattern"`
+
+	// +kubebuilder:validation:XValidation:rule="type(self) == int ? self > 0 : self.endsWith('%')",message="must be a positive integer or a percentage"
+	ValidXValidation intstr.IntOrString `json:"validXValidation"`
+}
+
+// +kubebuilder:validation:XValidation:rule="quantity(self.limit).compareTo(quantity(self.request)) >= 0",message="limit must not be less than request"
+// +kubebuilder:validation:XValidation:rule="!has(self.request) || isQuantity(self.request)",message="request must be a quantity"
+type ValidLimits struct {
+	Request resource.Quantity `json:"request"`
+
+	Limit resource.Quantity `json:"limit"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.limit >= self.request",message="limit must not be less than request"
+type InvalidLimits struct { // want "type InvalidLimits has an XValidation rule that uses quantity field request without quantity\\(\\). Quantities must be parsed with quantity\\(\\) to be compared" "type InvalidLimits has an XValidation rule that uses quantity field limit without quantity\\(\\). Quantities must be parsed with quantity\\(\\) to be compared"
+	Request resource.Quantity `json:"request"`
+
+	Limit resource.Quantity `json:"limit"`
+
+	// Fields that are not quantities are not checked.
+	Name string `json:"name"`
+}
+
+// +kubebuilder:validation:XValidation:rule="quantity(self.limit).isGreaterThan(self.request)",message="limit must be greater than request"
+type PartiallyInvalidLimits struct { // want "type PartiallyInvalidLimits has an XValidation rule that uses quantity field request without quantity\\(\\). Quantities must be parsed with quantity\\(\\) to be compared"
+	Request resource.Quantity `json:"request"`
+
+	Limit resource.Quantity `json:"limit"`
+}
+
+type QuantityFieldRules struct {
+	// +kubebuilder:validation:XValidation:rule="quantity(self).isGreaterThan(quantity('0'))",message="must be positive"
+	ValidRule resource.Quantity `json:"validRule"`
+
+	// +kubebuilder:validation:XValidation:rule="self != '0'",message="must not be zero"
+	InvalidRule resource.Quantity `json:"invalidRule"` // want "field QuantityFieldRules.InvalidRule has an XValidation rule that uses the quantity without quantity\\(\\). Quantities must be parsed with quantity\\(\\) to be compared"
+}
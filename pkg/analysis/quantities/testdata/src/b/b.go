@@ -0,0 +1,10 @@
+package b
+
+type Resources struct {
+	// The default names are not matched when names are configured.
+	Memory int64 `json:"memory"`
+
+	Disk string `json:"disk"` // want "field Resources.Disk appears to represent a resource quantity and should use resource.Quantity instead of string"
+
+	GPUCount int32 `json:"gpuCount"` // want "field Resources.GPUCount appears to represent a resource quantity and should use resource.Quantity instead of int32"
+}
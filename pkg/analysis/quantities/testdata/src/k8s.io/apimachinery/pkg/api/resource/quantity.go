@@ -0,0 +1,10 @@
+/*
+This is a copy of the minimum amount of the original file to be able to test the quantities linter.
+*/
+
+package resource
+
+// Quantity is a fixed-point representation of a number.
+type Quantity struct {
+	s string
+}
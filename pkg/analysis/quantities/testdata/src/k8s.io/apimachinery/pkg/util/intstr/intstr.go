@@ -0,0 +1,15 @@
+/*
+This is a copy of the minimum amount of the original file to be able to test the quantities linter.
+*/
+
+package intstr
+
+// IntOrString is a type that can hold an int32 or a string.
+type IntOrString struct {
+	Type   Type
+	IntVal int32
+	StrVal string
+}
+
+// Type represents the stored type of IntOrString.
+type Type int64
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package registry
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+// preferredMarkers maps each marker kind and style to the marker it prefers.
+//
+//nolint:gochecknoglobals
+var preferredMarkers = map[config.MarkerKind]map[config.MarkerStyle]string{
+	config.MarkerKindOptional: {
+		config.MarkerStyleStandard:    markers.OptionalMarker,
+		config.MarkerStyleKubebuilder: markers.KubebuilderOptionalMarker,
+		config.MarkerStyleDeclarative: markers.K8sOptionalMarker,
+	},
+	config.MarkerKindRequired: {
+		config.MarkerStyleStandard:    markers.RequiredMarker,
+		config.MarkerStyleKubebuilder: markers.KubebuilderRequiredMarker,
+		config.MarkerStyleDeclarative: markers.K8sRequiredMarker,
+	},
+	config.MarkerKindDefault: {
+		config.MarkerStyleStandard:    markers.DefaultMarker,
+		config.MarkerStyleKubebuilder: markers.KubebuilderDefaultMarker,
+		config.MarkerStyleDeclarative: markers.K8sDefaultMarker,
+	},
+}
+
+// applyPreferences sets any omitted settings within the linter config that correspond to
+// a shared preference to the preferred value.
+// Settings that do not support the preferred marker style are left unchanged.
+// It returns an error for each setting that contradicts the shared preference.
+func applyPreferences(linterConfig any, prefs config.Preferences, fldPath *field.Path) field.ErrorList {
+	inheritor, ok := linterConfig.(config.PreferencesInheritor)
+	if !ok {
+		return nil
+	}
+
+	fieldErrors := field.ErrorList{}
+
+	for _, pref := range inheritor.MarkerPreferences() {
+		style, prefPath := preferredStyle(prefs, pref.Kind)
+		if style == "" || !supportsStyle(pref, style) {
+			continue
+		}
+
+		preferred := preferredMarkers[pref.Kind][style]
+
+		switch *pref.Value {
+		case "":
+			*pref.Value = preferred
+		case preferred:
+		default:
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child(pref.Field), *pref.Value, fmt.Sprintf("contradicts %s %q, must be %q or omitted", prefPath, style, preferred)))
+		}
+	}
+
+	return fieldErrors
+}
+
+// preferredStyle returns the preferred marker style for the marker kind, and the
+// path of the preference that it was configured by.
+func preferredStyle(prefs config.Preferences, kind config.MarkerKind) (config.MarkerStyle, string) {
+	switch kind {
+	case config.MarkerKindOptional:
+		return prefs.OptionalMarker, "preferences.optionalMarker"
+	case config.MarkerKindRequired:
+		return prefs.RequiredMarker, "preferences.requiredMarker"
+	case config.MarkerKindDefault:
+		return prefs.DefaultMarker, "preferences.defaultMarker"
+	default:
+		return "", ""
+	}
+}
+
+// supportsStyle returns whether the linter setting supports the marker style.
+func supportsStyle(pref config.MarkerPreference, style config.MarkerStyle) bool {
+	return len(pref.Styles) == 0 || slices.Contains(pref.Styles, style)
+}
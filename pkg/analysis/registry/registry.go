@@ -54,7 +54,8 @@ type Registry interface {
 
 	// InitializeLinters returns a set of newly initialized linters based on the
 	// provided configuration.
-	InitializeLinters(config.Linters, config.LintersConfig) ([]*analysis.Analyzer, error)
+	// Linter configuration that is omitted is inherited from the shared preferences.
+	InitializeLinters(config.Linters, config.LintersConfig, config.Preferences) ([]*analysis.Analyzer, error)
 }
 
 type registry struct {
@@ -116,11 +117,11 @@ func (r *registry) allLinters() sets.Set[string] {
 }
 
 // InitializeLinters returns a list of initialized linters based on the provided config.
-func (r *registry) InitializeLinters(cfg config.Linters, lintersCfg config.LintersConfig) ([]*analysis.Analyzer, error) {
+func (r *registry) InitializeLinters(cfg config.Linters, lintersCfg config.LintersConfig, prefs config.Preferences) ([]*analysis.Analyzer, error) {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
-	if errs := r.validateLintersConfig(cfg, lintersCfg, field.NewPath("lintersConfig")); len(errs) > 0 {
+	if errs := r.validateLintersConfig(cfg, lintersCfg, prefs, field.NewPath("lintersConfig")); len(errs) > 0 {
 		return nil, fmt.Errorf("error validating linters config: %w", errs.ToAggregate())
 	}
 
@@ -137,6 +138,9 @@ func (r *registry) InitializeLinters(cfg config.Linters, lintersCfg config.Linte
 			if err != nil {
 				errs = append(errs, fmt.Errorf("failed to get linter config: %w", err))
 			}
+
+			// Contradictions have already been reported during validation.
+			_ = applyPreferences(linterConfig, prefs, nil)
 		}
 
 		a, err := init.Init(linterConfig)
@@ -152,8 +156,8 @@ func (r *registry) InitializeLinters(cfg config.Linters, lintersCfg config.Linte
 }
 
 // validateLintersConfig validates the provided linters config
-// against the set or registered linters.
-func (r *registry) validateLintersConfig(cfg config.Linters, lintersCfg config.LintersConfig, fieldPath *field.Path) field.ErrorList {
+// against the set or registered linters, and the shared preferences.
+func (r *registry) validateLintersConfig(cfg config.Linters, lintersCfg config.LintersConfig, prefs config.Preferences, fieldPath *field.Path) field.ErrorList {
 	fieldErrors := field.ErrorList{}
 	validatedLinters := sets.New[string]()
 
@@ -165,6 +169,11 @@ func (r *registry) validateLintersConfig(cfg config.Linters, lintersCfg config.L
 				continue
 			}
 
+			if errs := applyPreferences(linterConfig, prefs, fieldPath.Child(init.Name())); len(errs) > 0 {
+				fieldErrors = append(fieldErrors, errs...)
+				continue
+			}
+
 			fieldErrors = append(fieldErrors, ci.ValidateConfig(linterConfig, fieldPath.Child(init.Name()))...)
 
 			validatedLinters.Insert(init.Name())
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/conditions"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/defaults"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/jsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/nobools"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/optionalorrequired"
@@ -38,6 +39,7 @@ var _ = Describe("Registry", func() {
 
 		// Register a selection of linters to test the registry functionality.
 		r.RegisterLinter(conditions.Initializer())
+		r.RegisterLinter(defaults.Initializer())
 		r.RegisterLinter(jsontags.Initializer())
 		r.RegisterLinter(optionalorrequired.Initializer())
 		r.RegisterLinter(nobools.Initializer())
@@ -47,6 +49,7 @@ var _ = Describe("Registry", func() {
 		It("should return the default linters", func() {
 			Expect(r.DefaultLinters().UnsortedList()).To(ConsistOf(
 				"conditions",
+				"defaults",
 				"jsontags",
 				"optionalorrequired",
 			))
@@ -57,6 +60,7 @@ var _ = Describe("Registry", func() {
 		It("should return the all known linters", func() {
 			Expect(r.AllLinters().UnsortedList()).To(ConsistOf(
 				"conditions",
+				"defaults",
 				"jsontags",
 				"optionalorrequired",
 				"nobools",
@@ -68,12 +72,13 @@ var _ = Describe("Registry", func() {
 		type initLintersTableInput struct {
 			config        config.Linters
 			lintersConfig config.LintersConfig
+			preferences   config.Preferences
 
 			expectedLinters []string
 		}
 
 		DescribeTable("Initialize Linters", func(in initLintersTableInput) {
-			linters, err := r.InitializeLinters(in.config, in.lintersConfig)
+			linters, err := r.InitializeLinters(in.config, in.lintersConfig, in.preferences)
 			Expect(err).NotTo(HaveOccurred())
 
 			toLinterNames := func(a []*analysis.Analyzer) []string {
@@ -91,14 +96,14 @@ var _ = Describe("Registry", func() {
 			Entry("Empty config", initLintersTableInput{
 				config:          config.Linters{},
 				lintersConfig:   config.LintersConfig{},
-				expectedLinters: []string{"conditions", "jsontags", "optionalorrequired"},
+				expectedLinters: []string{"conditions", "defaults", "jsontags", "optionalorrequired"},
 			}),
 			Entry("With wildcard enabled linters", initLintersTableInput{
 				config: config.Linters{
 					Enable: []string{config.Wildcard},
 				},
 				lintersConfig:   config.LintersConfig{},
-				expectedLinters: []string{"conditions", "jsontags", "optionalorrequired", "nobools"},
+				expectedLinters: []string{"conditions", "defaults", "jsontags", "optionalorrequired", "nobools"},
 			}),
 			Entry("With wildcard enabled linters and a disabled linter", initLintersTableInput{
 				config: config.Linters{
@@ -106,7 +111,7 @@ var _ = Describe("Registry", func() {
 					Disable: []string{"jsontags"},
 				},
 				lintersConfig:   config.LintersConfig{},
-				expectedLinters: []string{"conditions", "optionalorrequired", "nobools"},
+				expectedLinters: []string{"conditions", "defaults", "optionalorrequired", "nobools"},
 			}),
 			Entry("With wildcard disabled linters", initLintersTableInput{
 				config: config.Linters{
@@ -123,6 +128,28 @@ var _ = Describe("Registry", func() {
 				lintersConfig:   config.LintersConfig{},
 				expectedLinters: []string{"jsontags"},
 			}),
+			Entry("With Declarative preferences", initLintersTableInput{
+				config:        config.Linters{},
+				lintersConfig: config.LintersConfig{},
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleDeclarative,
+					RequiredMarker: config.MarkerStyleDeclarative,
+					DefaultMarker:  config.MarkerStyleDeclarative,
+				},
+				expectedLinters: []string{"conditions", "defaults", "jsontags", "optionalorrequired"},
+			}),
+			Entry("With Declarative preferences and wildcard enabled linters", initLintersTableInput{
+				config: config.Linters{
+					Enable: []string{config.Wildcard},
+				},
+				lintersConfig: config.LintersConfig{},
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleDeclarative,
+					RequiredMarker: config.MarkerStyleDeclarative,
+					DefaultMarker:  config.MarkerStyleDeclarative,
+				},
+				expectedLinters: []string{"conditions", "defaults", "jsontags", "optionalorrequired", "nobools"},
+			}),
 		)
 	})
 
@@ -130,11 +157,12 @@ var _ = Describe("Registry", func() {
 		type validateLintersConfigTableInput struct {
 			linters     config.Linters
 			config      config.LintersConfig
+			preferences config.Preferences
 			expectedErr string
 		}
 
 		DescribeTable("Validate Linters Configuration through Initialization", func(in validateLintersConfigTableInput) {
-			_, err := r.InitializeLinters(in.linters, in.config)
+			_, err := r.InitializeLinters(in.linters, in.config, in.preferences)
 			if len(in.expectedErr) > 0 {
 				Expect(err).To(MatchError(in.expectedErr))
 			} else {
@@ -249,6 +277,68 @@ var _ = Describe("Registry", func() {
 				expectedErr: "error validating linters config: lintersConfig.optionalorrequired.preferredRequiredMarker: Invalid value: \"invalid\": invalid value, must be one of \"required\", \"kubebuilder:validation:Required\" or omitted",
 			}),
 
+			// Tests for shared preferences
+			Entry("With preferences and no linter config", validateLintersConfigTableInput{
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleKubebuilder,
+					RequiredMarker: config.MarkerStyleKubebuilder,
+				},
+				expectedErr: "",
+			}),
+			Entry("With preferences matching the linter config", validateLintersConfigTableInput{
+				config: config.LintersConfig{
+					"optionalorrequired": optionalorrequired.OptionalOrRequiredConfig{
+						PreferredOptionalMarker: markers.KubebuilderOptionalMarker,
+					},
+				},
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleKubebuilder,
+				},
+				expectedErr: "",
+			}),
+			Entry("With preferences contradicting the linter config", validateLintersConfigTableInput{
+				config: config.LintersConfig{
+					"optionalorrequired": optionalorrequired.OptionalOrRequiredConfig{
+						PreferredOptionalMarker: markers.KubebuilderOptionalMarker,
+					},
+				},
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleStandard,
+				},
+				expectedErr: "error validating linters config: lintersConfig.optionalorrequired.preferredOptionalMarker: Invalid value: \"kubebuilder:validation:Optional\": contradicts preferences.optionalMarker \"Standard\", must be \"optional\" or omitted",
+			}),
+			Entry("With preferences contradicting the config of a disabled linter should not error", validateLintersConfigTableInput{
+				linters: config.Linters{
+					Disable: []string{"optionalorrequired"},
+				},
+				config: config.LintersConfig{
+					"optionalorrequired": optionalorrequired.OptionalOrRequiredConfig{
+						PreferredOptionalMarker: markers.KubebuilderOptionalMarker,
+					},
+				},
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleStandard,
+				},
+				expectedErr: "",
+			}),
+			Entry("With preferences for a style that a linter does not support", validateLintersConfigTableInput{
+				preferences: config.Preferences{
+					RequiredMarker: config.MarkerStyleDeclarative,
+				},
+				expectedErr: "",
+			}),
+			Entry("With preferences for a style that a linter does not support and a linter config", validateLintersConfigTableInput{
+				config: config.LintersConfig{
+					"optionalorrequired": optionalorrequired.OptionalOrRequiredConfig{
+						PreferredOptionalMarker: markers.KubebuilderOptionalMarker,
+					},
+				},
+				preferences: config.Preferences{
+					OptionalMarker: config.MarkerStyleDeclarative,
+				},
+				expectedErr: "",
+			}),
+
 			// Tests for disabled linters with configuration
 			Entry("With config for explicitly disabled linter should not error", validateLintersConfigTableInput{
 				linters: config.Linters{
@@ -19,6 +19,7 @@ import (
 	"go/ast"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
@@ -33,6 +34,8 @@ const (
 )
 
 func init() {
+	checks.DefaultRegistry().Register(serialization.Checks(name)...)
+
 	markershelper.DefaultRegistry().Register(
 		markers.RequiredMarker,
 		markers.KubebuilderRequiredMarker,
@@ -48,6 +51,7 @@ func init() {
 
 type analyzer struct {
 	serializationCheck serialization.SerializationCheck
+	checks             checks.Config
 }
 
 // newAnalyzer creates a new analyzer.
@@ -59,6 +63,7 @@ func newAnalyzer(cfg *RequiredFieldsConfig) *analysis.Analyzer {
 	defaultConfig(cfg)
 
 	serializationCheck := serialization.New(&serialization.Config{
+		Linter: name,
 		Pointers: serialization.PointersConfig{
 			Policy: serialization.PointersPolicy(cfg.Pointers.Policy),
 			// We only allow the WhenRequired preference for required fields.
@@ -75,6 +80,7 @@ func newAnalyzer(cfg *RequiredFieldsConfig) *analysis.Analyzer {
 	})
 
 	a := &analyzer{
+		checks:             cfg.Checks,
 		serializationCheck: serializationCheck,
 	}
 
@@ -90,6 +96,8 @@ func newAnalyzer(cfg *RequiredFieldsConfig) *analysis.Analyzer {
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -15,6 +15,8 @@ limitations under the License.
 */
 package requiredfields
 
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
 // RequiredFieldsConfig contains configuration for the requiredfields linter.
 type RequiredFieldsConfig struct {
 	// pointers is the policy for pointers in required fields.
@@ -36,6 +38,11 @@ type RequiredFieldsConfig struct {
 	// Note, `omitzero` tag is supported in go version starting from go 1.24.
 	// Note, Configure omitzero policy to 'Forbid', if using with go version less than go 1.24.
 	OmitZero RequiredFieldsOmitZero `json:"omitzero"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `add-omitzero`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
 }
 
 // RequiredFieldsPointers is the configuration for pointers in required fields.
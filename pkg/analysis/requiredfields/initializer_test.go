@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/requiredfields"
 )
@@ -129,6 +130,22 @@ var _ = Describe("requiredfields initializer", func() {
 				},
 				expectedErr: "requiredfields.omitzero.policy: Invalid value: \"invalid\": invalid value, must be one of \"SuggestFix\", \"Warn\", \"Forbid\" or omitted",
 			}),
+			Entry("With a valid RequiredFieldsConfig: Checks: Disable", testCase{
+				config: requiredfields.RequiredFieldsConfig{
+					Checks: checks.Config{
+						Disable: []string{"add-omitzero"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid RequiredFieldsConfig: Checks: unknown check", testCase{
+				config: requiredfields.RequiredFieldsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "requiredfields.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: add-omitempty,add-omitzero,add-pointer,invalid-marker,remove-omitzero,remove-pointer",
+			}),
 		)
 	})
 })
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package schemasize
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "schemasize"
+
+	defaultMaxDepth           = 16
+	defaultMaxProperties      = 5000
+	defaultMaxSchemaSizeBytes = 1024 * 1024
+)
+
+//nolint:gochecknoglobals
+var (
+	maxDepthCheck      = checks.New(name, "max-depth", config.SeverityWarning)
+	maxPropertiesCheck = checks.New(name, "max-properties", config.SeverityWarning)
+	maxSchemaSizeCheck = checks.New(name, "max-schema-size", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(maxDepthCheck, maxPropertiesCheck, maxSchemaSizeCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderRootMarker,
+		markers.KubebuilderSchemaLessMarker,
+		markers.KubebuilderPreserveUnknownFieldsMarker,
+	)
+}
+
+type analyzer struct {
+	maxDepth           int
+	maxProperties      int
+	maxSchemaSizeBytes int
+	checks             checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *SchemaSizeConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &SchemaSizeConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		maxDepth:           cfg.MaxDepth,
+		maxProperties:      cfg.MaxProperties,
+		maxSchemaSizeBytes: cfg.MaxSchemaSizeBytes,
+		checks:             cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that the schemas of root objects are within limits for nesting depth, number of properties and estimated size.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	var walker *schemaWalker
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		if !utils.IsRootType(typeSpec, markersAccess.TypeMarkers(typeSpec)) {
+			return
+		}
+
+		obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+		if !ok {
+			return
+		}
+
+		if walker == nil {
+			walker = newSchemaWalker(pass, markersAccess)
+		}
+
+		a.checkRoot(pass, typeSpec, walker.walkRoot(obj.Type()))
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+func (a *analyzer) checkRoot(pass *analysis.Pass, typeSpec *ast.TypeSpec, root *schemaNode) {
+	typeName := typeSpec.Name.Name
+
+	if root.deepest.depth > a.maxDepth {
+		maxDepthCheck.Reportf(pass, typeSpec.Pos(), "root type %s has a maximum nesting depth of %d, which exceeds the maximum of %d. The deepest path is %s", typeName, root.deepest.depth, a.maxDepth, root.deepest.path)
+	}
+
+	if root.properties > a.maxProperties {
+		heaviest := root.heaviest(func(n *schemaNode) int { return n.properties })
+		maxPropertiesCheck.Reportf(pass, typeSpec.Pos(), "root type %s has %d properties, which exceeds the maximum of %d. The heaviest path is %s, with %d properties", typeName, root.properties, a.maxProperties, heaviest.path, heaviest.properties)
+	}
+
+	if root.bytes > a.maxSchemaSizeBytes {
+		heaviest := root.heaviest(func(n *schemaNode) int { return n.bytes })
+		maxSchemaSizeCheck.Reportf(pass, typeSpec.Pos(), "root type %s has an estimated schema size of %d bytes, which exceeds the maximum of %d bytes. The heaviest path is %s, with an estimated %d bytes", typeName, root.bytes, a.maxSchemaSizeBytes, heaviest.path, heaviest.bytes)
+	}
+}
+
+func defaultConfig(cfg *SchemaSizeConfig) {
+	if cfg.MaxDepth == 0 {
+		cfg.MaxDepth = defaultMaxDepth
+	}
+
+	if cfg.MaxProperties == 0 {
+		cfg.MaxProperties = defaultMaxProperties
+	}
+
+	if cfg.MaxSchemaSizeBytes == 0 {
+		cfg.MaxSchemaSizeBytes = defaultMaxSchemaSizeBytes
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package schemasize
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// SchemaSizeConfig contains configuration for the schemasize linter.
+type SchemaSizeConfig struct {
+	// maxDepth is the maximum nesting depth of the schema of a root object.
+	// The depth of a property is the number of properties in its path from the root object,
+	// e.g. `spec.template.spec` has a depth of 3. Arrays and maps do not add to the depth.
+	// When otherwise not specified, the default value is 16.
+	MaxDepth int `json:"maxDepth"`
+
+	// maxProperties is the maximum number of properties within the schema of a root object,
+	// counting each property once for each path at which it can be reached.
+	// When otherwise not specified, the default value is 5000.
+	MaxProperties int `json:"maxProperties"`
+
+	// maxSchemaSizeBytes is the maximum estimated size, in bytes, of the schema of a root object.
+	// The estimate accounts for the name, description and validation of each property,
+	// but descriptions are only available for types declared within the package being linted.
+	// When otherwise not specified, the default value is 1048576 (1MiB).
+	MaxSchemaSizeBytes int `json:"maxSchemaSizeBytes"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `max-depth`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
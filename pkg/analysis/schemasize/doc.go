@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `schemasize` linter checks that the schemas of root objects stay within limits for nesting depth, number of properties and estimated size.
+
+Very deep or wide schemas can exceed the etcd object size limit once they are published within a CustomResourceDefinition,
+and make the OpenAPI schema for the API expensive to publish and to consume.
+
+For each root object, that is, a type marked with `+kubebuilder:object:root=true` or embedding `TypeMeta`,
+the linter follows the types of its fields, including types from other packages, and computes:
+  - The maximum nesting depth, that is, the number of properties in the path to the deepest property.
+  - The total number of properties, counting each property once for each path at which it can be reached.
+  - An estimated size of the schema, in bytes, based on the name and description of each property.
+
+Recursive types are followed once, and the schema below fields marked as schemaless is not counted.
+The `ObjectMeta` and `ListMeta` types are not expanded, matching the generated schema.
+
+When a limit is exceeded, the linter reports the deepest path, or the path contributing the most properties or bytes,
+to identify the part of the API that should be refactored.
+*/
+package schemasize
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package schemasize_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/schemasize"
+)
+
+var _ = Describe("schemasize initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      schemasize.SchemaSizeConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := schemasize.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("schemasize"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid SchemaSizeConfig", testCase{
+				config:      schemasize.SchemaSizeConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid SchemaSizeConfig: all thresholds set", testCase{
+				config: schemasize.SchemaSizeConfig{
+					MaxDepth:           10,
+					MaxProperties:      1000,
+					MaxSchemaSizeBytes: 512 * 1024,
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid SchemaSizeConfig: MaxDepth", testCase{
+				config: schemasize.SchemaSizeConfig{
+					MaxDepth: -1,
+				},
+				expectedErr: "schemasize.maxDepth: Invalid value: -1: invalid value, must be a positive integer or omitted",
+			}),
+			Entry("With an invalid SchemaSizeConfig: MaxProperties", testCase{
+				config: schemasize.SchemaSizeConfig{
+					MaxProperties: -1,
+				},
+				expectedErr: "schemasize.maxProperties: Invalid value: -1: invalid value, must be a positive integer or omitted",
+			}),
+			Entry("With an invalid SchemaSizeConfig: MaxSchemaSizeBytes", testCase{
+				config: schemasize.SchemaSizeConfig{
+					MaxSchemaSizeBytes: -1,
+				},
+				expectedErr: "schemasize.maxSchemaSizeBytes: Invalid value: -1: invalid value, must be a positive integer or omitted",
+			}),
+			Entry("With an invalid SchemaSizeConfig: Checks: unknown check", testCase{
+				config: schemasize.SchemaSizeConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "schemasize.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: max-depth,max-properties,max-schema-size",
+			}),
+		)
+	})
+})
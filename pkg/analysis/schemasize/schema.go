@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package schemasize
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	// propertyOverheadBytes is the estimated size of the schema of a property, excluding its name and description.
+	// This accounts for the surrounding JSON syntax, and the type of the property.
+	propertyOverheadBytes = 40
+
+	metav1PkgPath = "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// schemaNode is a property within the schema of a root object.
+type schemaNode struct {
+	// path is the serialized path to the property from the root object.
+	path string
+
+	// depth is the number of properties within the path.
+	depth int
+
+	// bytes is the estimated size of the schema of the property, including its children.
+	bytes int
+
+	// properties is the number of properties within the schema of the property, including itself.
+	properties int
+
+	// deepest is the deepest property within the schema of the property, including itself.
+	deepest *schemaNode
+
+	children []*schemaNode
+}
+
+// summarise computes the totals for the node from its children.
+func (n *schemaNode) summarise() {
+	n.deepest = n
+
+	for _, child := range n.children {
+		n.bytes += child.bytes
+		n.properties += child.properties
+
+		if child.deepest.depth > n.deepest.depth {
+			n.deepest = child.deepest
+		}
+	}
+}
+
+// heaviest returns the property that contributes the most to the weight of the node.
+// It descends from the node into the heaviest child, for as long as that child accounts
+// for at least half of the weight of its parent.
+func (n *schemaNode) heaviest(weight func(*schemaNode) int) *schemaNode {
+	current := n
+
+	for {
+		var heaviestChild *schemaNode
+
+		for _, child := range current.children {
+			if heaviestChild == nil || weight(child) > weight(heaviestChild) {
+				heaviestChild = child
+			}
+		}
+
+		if heaviestChild == nil || (current != n && weight(heaviestChild)*2 < weight(current)) {
+			return current
+		}
+
+		current = heaviestChild
+	}
+}
+
+// schemaWalker builds the schema tree for root objects.
+// Types are walked using the type information so that types from other packages are included.
+// Where fields are declared within the package being analyzed, their documentation and markers are also used.
+type schemaWalker struct {
+	markers markershelper.Markers
+	fields  map[token.Pos]*ast.Field
+}
+
+func newSchemaWalker(pass *analysis.Pass, markersAccess markershelper.Markers) *schemaWalker {
+	return &schemaWalker{
+		markers: markersAccess,
+		fields:  utils.FieldsByPos(pass),
+	}
+}
+
+// walkRoot returns the schema tree for the root object type.
+func (w *schemaWalker) walkRoot(typ types.Type) *schemaNode {
+	root := &schemaNode{}
+	root.children = w.walkType(typ, "", 0, sets.New[*types.Named]())
+	root.summarise()
+
+	return root
+}
+
+// walkType returns the properties defined by the type.
+// Named types that are already being visited are skipped to prevent infinite recursion on recursive types.
+func (w *schemaWalker) walkType(typ types.Type, path string, depth int, visiting sets.Set[*types.Named]) []*schemaNode {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Named:
+		if isMetaType(t) || visiting.Has(t) {
+			return nil
+		}
+
+		visiting.Insert(t)
+		defer visiting.Delete(t)
+
+		return w.walkType(t.Underlying(), path, depth, visiting)
+	case *types.Pointer:
+		return w.walkType(t.Elem(), path, depth, visiting)
+	case *types.Slice:
+		return w.walkType(t.Elem(), path+utils.ListElementPathSegment, depth, visiting)
+	case *types.Array:
+		return w.walkType(t.Elem(), path+utils.ListElementPathSegment, depth, visiting)
+	case *types.Map:
+		return w.walkType(t.Elem(), path+utils.MapValuePathSegment, depth, visiting)
+	case *types.Struct:
+		return w.walkStruct(t, path, depth, visiting)
+	}
+
+	return nil
+}
+
+// walkStruct returns a property for each serialized field of the struct.
+// Embedded and inline structs are flattened into their parent.
+func (w *schemaWalker) walkStruct(sTyp *types.Struct, path string, depth int, visiting sets.Set[*types.Named]) []*schemaNode {
+	nodes := []*schemaNode{}
+
+	for i := range sTyp.NumFields() {
+		field := sTyp.Field(i)
+		if !field.Exported() {
+			continue
+		}
+
+		tagName, inline, ignored := utils.ParseJSONTag(sTyp.Tag(i))
+
+		switch {
+		case ignored:
+			continue
+		case inline, field.Embedded() && tagName == "":
+			nodes = append(nodes, w.walkType(field.Type(), path, depth, visiting)...)
+			continue
+		case tagName == "":
+			tagName = field.Name()
+		}
+
+		node := &schemaNode{
+			path:       utils.JoinPath(path, tagName),
+			depth:      depth + 1,
+			bytes:      propertyOverheadBytes + len(tagName),
+			properties: 1,
+		}
+
+		astField, hasASTField := w.fields[field.Pos()]
+		if hasASTField && astField.Doc != nil {
+			node.bytes += len(strings.TrimSpace(astField.Doc.Text()))
+		}
+
+		if !hasASTField || !isSchemaless(w.markers.FieldMarkers(astField)) {
+			node.children = w.walkType(field.Type(), node.path, node.depth, visiting)
+		}
+
+		node.summarise()
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// isMetaType determines whether the type is one of the metav1 object metadata types,
+// which are not expanded within the generated schema.
+func isMetaType(named *types.Named) bool {
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != metav1PkgPath {
+		return false
+	}
+
+	return obj.Name() == "ObjectMeta" || obj.Name() == "ListMeta"
+}
+
+// isSchemaless determines whether the schema below the field is omitted from the generated schema.
+func isSchemaless(markerSet markershelper.MarkerSet) bool {
+	return markerSet.Has(markers.KubebuilderSchemaLessMarker) || markerSet.Has(markers.KubebuilderPreserveUnknownFieldsMarker)
+}
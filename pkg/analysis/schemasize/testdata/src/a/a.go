@@ -0,0 +1,102 @@
+package a
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+type Small struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SmallSpec `json:"spec"`
+}
+
+type SmallSpec struct {
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+type Deep struct { // want "root type Deep has a maximum nesting depth of 4, which exceeds the maximum of 3. The deepest path is spec.template.items\\[\\*\\].name"
+	Spec DeepSpec `json:"spec"`
+}
+
+type DeepSpec struct {
+	Template Template `json:"template"`
+}
+
+type Template struct {
+	Items []Item `json:"items"`
+}
+
+type Item struct {
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+type Wide struct { // want "root type Wide has 11 properties, which exceeds the maximum of 10. The heaviest path is spec, with 9 properties"
+	Spec WideSpec `json:"spec"`
+
+	Status WideStatus `json:"status"`
+}
+
+type WideSpec struct {
+	A string `json:"a"`
+	B string `json:"b"`
+	C string `json:"c"`
+	D string `json:"d"`
+	E string `json:"e"`
+	F string `json:"f"`
+	G string `json:"g"`
+	H string `json:"h"`
+}
+
+type WideStatus struct {
+	Ready bool `json:"ready"`
+}
+
+// +kubebuilder:object:root=true
+type Large struct { // want "root type Large has an estimated schema size of [0-9]+ bytes, which exceeds the maximum of 1000 bytes. The heaviest path is spec.description, with an estimated [0-9]+ bytes"
+	Spec LargeSpec `json:"spec"`
+
+	// Status is small.
+	Status WideStatus `json:"status"`
+}
+
+type LargeSpec struct {
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	// Description is a field with a long description, which contributes to the estimated size of the schema.
+	Description string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+type Recursive struct {
+	Spec Node `json:"spec"`
+}
+
+type Node struct {
+	Name string `json:"name"`
+
+	Children []Node `json:"children"`
+}
+
+// +kubebuilder:object:root=true
+type Opaque struct {
+	// +kubebuilder:validation:Schemaless
+	Spec DeepSpec `json:"spec"`
+}
+
+// NotRoot is deeply nested, but is not a root object.
+type NotRoot struct {
+	Deep Deep `json:"deep"`
+}
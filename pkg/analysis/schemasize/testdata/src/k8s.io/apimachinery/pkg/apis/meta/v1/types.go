@@ -0,0 +1,19 @@
+// This is a copy of the minimum amount of the original file to be able to test the schemasize linter.
+package v1
+
+// TypeMeta describes an individual object in an API response or request
+// with strings representing the type of the object and its API schema version.
+type TypeMeta struct {
+	Kind string `json:"kind,omitempty"`
+
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// ObjectMeta is metadata that all persisted resources must have.
+type ObjectMeta struct {
+	Name string `json:"name,omitempty"`
+
+	Namespace string `json:"namespace,omitempty"`
+
+	Labels map[string]string `json:"labels,omitempty"`
+}
@@ -21,11 +21,13 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	kubebuildermarkers "sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -37,8 +39,32 @@ const (
 	listTypeMap    = "map"
 )
 
+//nolint:gochecknoglobals
+var (
+	byteArrayListTypeCheck = checks.New(name, "byte-array-listtype", config.SeverityError)
+	missingListTypeCheck   = checks.New(name, "missing-listtype", config.SeverityWarning)
+	invalidListTypeCheck   = checks.New(name, "invalid-listtype", config.SeverityError)
+	primitiveListMapCheck  = checks.New(name, "primitive-listtype-map", config.SeverityError)
+	missingListMapKeyCheck = checks.New(name, "missing-listmapkey", config.SeverityError)
+	unknownListMapKeyCheck = checks.New(name, "unknown-listmapkey", config.SeverityError)
+	listTypeSetCheck       = checks.New(name, "listtype-set", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(
+		byteArrayListTypeCheck,
+		missingListTypeCheck,
+		invalidListTypeCheck,
+		primitiveListMapCheck,
+		missingListMapKeyCheck,
+		unknownListMapKeyCheck,
+		listTypeSetCheck,
+	)
+}
+
 type analyzer struct {
 	listTypeSetUsage SSATagsListTypeSetUsage
+	checks           checks.Config
 }
 
 func newAnalyzer(cfg *SSATagsConfig) *analysis.Analyzer {
@@ -49,6 +75,7 @@ func newAnalyzer(cfg *SSATagsConfig) *analysis.Analyzer {
 	defaultConfig(cfg)
 
 	a := &analyzer{
+		checks:           cfg.Checks,
 		listTypeSetUsage: cfg.ListTypeSetUsage,
 	}
 
@@ -61,6 +88,8 @@ func newAnalyzer(cfg *SSATagsConfig) *analysis.Analyzer {
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -87,7 +116,7 @@ func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, markersAcce
 	if utils.IsByteArray(pass, field) {
 		listTypeMarkers := fieldMarkers.Get(kubebuildermarkers.KubebuilderListTypeMarker)
 		for _, marker := range listTypeMarkers {
-			pass.Report(analysis.Diagnostic{
+			byteArrayListTypeCheck.Report(pass, analysis.Diagnostic{
 				Pos:     field.Pos(),
 				Message: fmt.Sprintf("%s is a byte array, which does not support the listType marker. Remove the listType marker", qualifiedFieldName),
 				SuggestedFixes: []analysis.SuggestedFix{
@@ -111,7 +140,7 @@ func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, markersAcce
 	listTypeMarkers := fieldMarkers.Get(kubebuildermarkers.KubebuilderListTypeMarker)
 
 	if len(listTypeMarkers) == 0 {
-		pass.Report(analysis.Diagnostic{
+		missingListTypeCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("%s should have a listType marker for proper Server-Side Apply behavior (atomic, set, or map)", qualifiedFieldName),
 		})
@@ -136,7 +165,7 @@ func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, markersAcce
 
 func (a *analyzer) checkListTypeMarker(pass *analysis.Pass, listType string, field *ast.Field, qualifiedFieldName string) {
 	if !validListType(listType) {
-		pass.Report(analysis.Diagnostic{
+		invalidListTypeCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("%s has invalid listType %q, must be one of: atomic, set, map", qualifiedFieldName, listType),
 		})
@@ -151,7 +180,7 @@ func (a *analyzer) checkListTypeMap(pass *analysis.Pass, fieldMarkers markers.Ma
 	isObjectList := utils.IsObjectList(pass, field)
 
 	if !isObjectList {
-		pass.Report(analysis.Diagnostic{
+		primitiveListMapCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("%s with listType=map can only be used for object lists, not primitive lists", qualifiedFieldName),
 		})
@@ -160,7 +189,7 @@ func (a *analyzer) checkListTypeMap(pass *analysis.Pass, fieldMarkers markers.Ma
 	}
 
 	if len(listMapKeyMarkers) == 0 {
-		pass.Report(analysis.Diagnostic{
+		missingListMapKeyCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("%s with listType=map must have at least one listMapKey marker", qualifiedFieldName),
 		})
@@ -186,7 +215,7 @@ func (a *analyzer) checkListTypeSet(pass *analysis.Pass, field *ast.Field, quali
 		Message: fmt.Sprintf("%s with listType=set is not recommended due to Server-Side Apply compatibility issues. Consider using listType=%s or listType=%s instead", qualifiedFieldName, listTypeAtomic, listTypeMap),
 	}
 
-	pass.Report(diagnostic)
+	listTypeSetCheck.Report(pass, diagnostic)
 }
 
 func (a *analyzer) validateListMapKeys(pass *analysis.Pass, field *ast.Field, listMapKeyMarkers []markers.Marker, qualifiedFieldName string) {
@@ -207,7 +236,7 @@ func (a *analyzer) validateListMapKeys(pass *analysis.Pass, field *ast.Field, li
 		}
 
 		if !a.hasFieldWithJSONTag(structFields, jsonTags, keyName) {
-			pass.Report(analysis.Diagnostic{
+			unknownListMapKeyCheck.Report(pass, analysis.Diagnostic{
 				Pos:     field.Pos(),
 				Message: fmt.Sprintf("%s listMapKey %q does not exist as a field in the struct", qualifiedFieldName, keyName),
 			})
@@ -241,7 +270,7 @@ func (a *analyzer) getStructFieldsFromExpr(pass *analysis.Pass, jsonTags extract
 			return nil
 		}
 
-		return flattenStructFields(pass, jsonTags, structType.Fields)
+		return utils.FlattenStructFields(pass, jsonTags, structType.Fields)
 	case *ast.StarExpr:
 		return a.getStructFieldsFromExpr(pass, jsonTags, elementType.X)
 	case *ast.SelectorExpr:
@@ -281,44 +310,3 @@ func defaultConfig(cfg *SSATagsConfig) {
 		cfg.ListTypeSetUsage = SSATagsListTypeSetUsageWarn
 	}
 }
-
-// flattenStructFields flattens a struct's fields by looking for embedded structs and promoting their fields to the top level.
-// This allows us to correctly check listMapKey markers where the map key is a member of the embedded struct.
-func flattenStructFields(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, fields *ast.FieldList) *ast.FieldList {
-	if fields == nil {
-		return nil
-	}
-
-	flattenedFields := &ast.FieldList{}
-
-	for _, field := range fields.List {
-		tagInfo := jsonTags.FieldTags(field)
-		if len(field.Names) > 0 || tagInfo.Name != "" {
-			// Field is not embedded, it has an explicit name.
-			flattenedFields.List = append(flattenedFields.List, field)
-			continue
-		}
-
-		ident, ok := field.Type.(*ast.Ident)
-		if !ok {
-			flattenedFields.List = append(flattenedFields.List, field)
-			continue
-		}
-
-		typeSpec, ok := utils.LookupTypeSpec(pass, ident)
-		if !ok {
-			flattenedFields.List = append(flattenedFields.List, field)
-			continue
-		}
-
-		embeddedStruct, ok := typeSpec.Type.(*ast.StructType)
-		if !ok {
-			flattenedFields.List = append(flattenedFields.List, field)
-			continue
-		}
-
-		flattenedFields.List = append(flattenedFields.List, flattenStructFields(pass, jsonTags, embeddedStruct.Fields).List...)
-	}
-
-	return flattenedFields
-}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"golang.org/x/tools/go/analysis/analysistest"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/ssatags"
 )
 
@@ -45,3 +46,18 @@ func TestWithListTypeSetUsageIgnore(t *testing.T) {
 
 	analysistest.RunWithSuggestedFixes(t, testdata, a, "b")
 }
+
+func TestWithDisabledChecks(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	a, err := ssatags.Initializer().Init(&ssatags.SSATagsConfig{
+		Checks: checks.Config{
+			Disable: []string{"missing-listtype", "unknown-listmapkey"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, a, "c")
+}
@@ -15,6 +15,8 @@ limitations under the License.
 */
 package ssatags
 
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
 // SSATagsConfig contains configuration for the ssatags linter.
 type SSATagsConfig struct {
 	// listTypeSetUsage is the policy for the listType=set usage.
@@ -25,6 +27,11 @@ type SSATagsConfig struct {
 	// Server-Side Apply compatibility issues specific to object arrays.
 	// When otherwise not specified, the default value is "Warn".
 	ListTypeSetUsage SSATagsListTypeSetUsage `json:"listTypeSetUsage"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `missing-listtype`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
 }
 
 // SSATagsListTypeSetUsage is the policy for the listType=set usage in the ssatags linter.
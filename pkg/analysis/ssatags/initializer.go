@@ -20,6 +20,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
 )
@@ -60,5 +61,7 @@ func validateConfig(stc *SSATagsConfig, fldPath *field.Path) field.ErrorList {
 		fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("listTypeSetUsage"), stc.ListTypeSetUsage, fmt.Sprintf("invalid value, must be one of %q, %q or omitted", SSATagsListTypeSetUsageWarn, SSATagsListTypeSetUsageIgnore)))
 	}
 
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(stc.Checks, name, fldPath.Child("checks"))...)
+
 	return fieldErrors
 }
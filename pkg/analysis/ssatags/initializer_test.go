@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/ssatags"
 )
@@ -60,6 +61,31 @@ var _ = Describe("ssatags initializer", func() {
 				},
 				expectedErr: "ssatags.listTypeSetUsage: Invalid value: \"invalid\": invalid value, must be one of \"Warn\", \"Ignore\" or omitted",
 			}),
+			Entry("With disabled checks", testCase{
+				config: ssatags.SSATagsConfig{
+					Checks: checks.Config{
+						Disable: []string{"missing-listtype", "listtype-set"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With all checks disabled and one enabled", testCase{
+				config: ssatags.SSATagsConfig{
+					Checks: checks.Config{
+						Enable:  []string{"invalid-listtype"},
+						Disable: []string{"*"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an unknown check", testCase{
+				config: ssatags.SSATagsConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "ssatags.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: byte-array-listtype,invalid-listtype,listtype-set,missing-listmapkey,missing-listtype,primitive-listtype-map,unknown-listmapkey",
+			}),
 		)
 	})
 })
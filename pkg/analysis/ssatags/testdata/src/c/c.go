@@ -0,0 +1,23 @@
+package c
+
+type Item struct {
+	Name string `json:"name"`
+}
+
+type ChecksTestSpec struct {
+	// Missing listType is disabled, so this should pass.
+	MissingListType []string `json:"missingListType,omitempty"`
+
+	// Unknown listMapKey is disabled, so this should pass.
+	// +listType=map
+	// +listMapKey=unknown
+	UnknownListMapKey []Item `json:"unknownListMapKey,omitempty"`
+
+	// Invalid listType is still enabled.
+	// +listType=invalid
+	InvalidListType []string `json:"invalidListType,omitempty"` // want "ChecksTestSpec.InvalidListType has invalid listType \"invalid\", must be one of: atomic, set, map"
+
+	// listType=map without listMapKey is still enabled.
+	// +listType=map
+	MissingListMapKey []Item `json:"missingListMapKey,omitempty"` // want "ChecksTestSpec.MissingListMapKey with listType=map must have at least one listMapKey marker"
+}
@@ -21,11 +21,13 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -35,7 +37,15 @@ const (
 	statusJSONTag = "status"
 )
 
+//nolint:gochecknoglobals
+var (
+	notRequiredCheck     = checks.New(name, "not-required", config.SeverityWarning)
+	missingOptionalCheck = checks.New(name, "missing-optional", config.SeverityWarning)
+)
+
 func init() {
+	checks.DefaultRegistry().Register(notRequiredCheck, missingOptionalCheck)
+
 	markershelper.DefaultRegistry().Register(
 		markers.OptionalMarker,
 		markers.KubebuilderOptionalMarker,
@@ -48,16 +58,18 @@ func init() {
 
 type analyzer struct {
 	preferredOptionalMarker string
+	checks                  checks.Config
 }
 
 // newAnalyzer creates a new analyzer.
-func newAnalyzer(preferredOptionalMarker string) *analysis.Analyzer {
+func newAnalyzer(preferredOptionalMarker string, checksConfig checks.Config) *analysis.Analyzer {
 	if preferredOptionalMarker == "" {
 		preferredOptionalMarker = markers.OptionalMarker
 	}
 
 	a := &analyzer{
 		preferredOptionalMarker: preferredOptionalMarker,
+		checks:                  checksConfig,
 	}
 
 	return &analysis.Analyzer{
@@ -69,6 +81,8 @@ func newAnalyzer(preferredOptionalMarker string) *analysis.Analyzer {
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -162,7 +176,7 @@ func (a *analyzer) reportAndReplaceRequiredMarkers(pass *analysis.Pass, field *a
 		NewText: fmt.Appendf(nil, "// +%s\n", a.preferredOptionalMarker),
 	})
 
-	pass.Report(analysis.Diagnostic{
+	notRequiredCheck.Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		Message: fmt.Sprintf("status field %q must be marked as optional, not required", fieldName),
 		SuggestedFixes: []analysis.SuggestedFix{{
@@ -175,7 +189,7 @@ func (a *analyzer) reportAndReplaceRequiredMarkers(pass *analysis.Pass, field *a
 // reportAndAddOptionalMarker reports an error and suggests adding an optional marker.
 // TODO: consolidate the logic for removing markers with other linters.
 func (a *analyzer) reportAndAddOptionalMarker(pass *analysis.Pass, field *ast.Field, fieldName string) {
-	pass.Report(analysis.Diagnostic{
+	missingOptionalCheck.Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		Message: fmt.Sprintf("status field %q must be marked as optional", fieldName),
 		SuggestedFixes: []analysis.SuggestedFix{
@@ -15,10 +15,27 @@ limitations under the License.
 */
 package statusoptional
 
+import (
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
 // StatusOptionalConfig contains configuration for the statusoptional linter.
 type StatusOptionalConfig struct {
 	// preferredOptionalMarker is the preferred marker to use for optional fields.
-	// If this field is not set, the default value is "optional".
+	// If this field is not set, it is inherited from `preferences.optionalMarker`, or the default value is "optional".
 	// Valid values are "optional", "kubebuilder:validation:Optional" and "k8s:optional".
 	PreferredOptionalMarker string `json:"preferredOptionalMarker"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `missing-optional`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
+
+// MarkerPreferences returns the settings that may be inherited from the shared preferences.
+func (c *StatusOptionalConfig) MarkerPreferences() []config.MarkerPreference {
+	return []config.MarkerPreference{
+		{Kind: config.MarkerKindOptional, Field: "preferredOptionalMarker", Value: &c.PreferredOptionalMarker},
+	}
 }
@@ -20,6 +20,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
@@ -45,7 +46,7 @@ func initAnalyzer(soc *StatusOptionalConfig) (*analysis.Analyzer, error) {
 		soc = &StatusOptionalConfig{}
 	}
 
-	return newAnalyzer(soc.PreferredOptionalMarker), nil
+	return newAnalyzer(soc.PreferredOptionalMarker, soc.Checks), nil
 }
 
 // validateConfig is used to validate the configuration in the config.StatusOptionalConfig struct.
@@ -62,5 +63,7 @@ func validateConfig(soc *StatusOptionalConfig, fldPath *field.Path) field.ErrorL
 		fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("preferredOptionalMarker"), soc.PreferredOptionalMarker, fmt.Sprintf("invalid value, must be one of %q, %q, %q or omitted", markers.OptionalMarker, markers.KubebuilderOptionalMarker, markers.K8sOptionalMarker)))
 	}
 
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(soc.Checks, name, fldPath.Child("checks"))...)
+
 	return fieldErrors
 }
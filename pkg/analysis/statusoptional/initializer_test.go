@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/statusoptional"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
@@ -67,6 +68,14 @@ var _ = Describe("statusoptional initializer", func() {
 				},
 				expectedErr: "statusoptional.preferredOptionalMarker: Invalid value: \"invalid\": invalid value, must be one of \"optional\", \"kubebuilder:validation:Optional\", \"k8s:optional\" or omitted",
 			}),
+			Entry("With an invalid StatusOptionalConfig: Checks: unknown check", testCase{
+				config: statusoptional.StatusOptionalConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "statusoptional.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: missing-optional,not-required",
+			}),
 		)
 	})
 })
@@ -19,14 +19,19 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"slices"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -34,17 +39,54 @@ const (
 	name = "statussubresource"
 
 	statusJSONTag = "status"
+
+	specPathArgument     = "specpath"
+	statusPathArgument   = "statuspath"
+	selectorPathArgument = "selectorpath"
+)
+
+//nolint:gochecknoglobals
+var (
+	missingStatusFieldCheck  = checks.New(name, "missing-status-field", config.SeverityError)
+	missingStatusMarkerCheck = checks.New(name, "missing-status-marker", config.SeverityWarning)
+	scaleStatusMarkerCheck   = checks.New(name, "scale-missing-status-marker", config.SeverityError)
+	scalePathCheck           = checks.New(name, "scale-path", config.SeverityError)
+	scalePathTypeCheck       = checks.New(name, "scale-path-type", config.SeverityError)
 )
 
-// Analyzer is a analyzer for the statussubresource package.
-var Analyzer = &analysis.Analyzer{
-	Name:     name,
-	Doc:      "Checks that a type marked with kubebuilder:object:root:=true and containing a status field is marked with kubebuilder:subresource:status",
-	Run:      run,
-	Requires: []*analysis.Analyzer{inspect.Analyzer, markershelper.Analyzer, extractjsontags.Analyzer},
+func init() {
+	checks.DefaultRegistry().Register(missingStatusFieldCheck, missingStatusMarkerCheck, scaleStatusMarkerCheck, scalePathCheck, scalePathTypeCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderScaleSubresourceMarker,
+	)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *StatusSubresourceConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &StatusSubresourceConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that a type marked with kubebuilder:object:root:=true and containing a status field is marked with kubebuilder:subresource:status, and that the paths of the kubebuilder:subresource:scale marker resolve to fields of the expected types",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspect.Analyzer, markershelper.Analyzer, extractjsontags.Analyzer},
+	}
 }
 
-func run(pass *analysis.Pass) (any, error) {
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
 	inspect, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
 		return nil, kalerrors.ErrCouldNotGetInspector
@@ -84,6 +126,7 @@ func run(pass *analysis.Pass) (any, error) {
 
 		structMarkers := markersAccess.StructMarkers(sTyp)
 		checkStruct(pass, sTyp, typeSpec.Name.Name, structMarkers, jsonTags)
+		checkScale(pass, typeSpec, sTyp, structMarkers)
 	})
 
 	return nil, nil //nolint:nilnil
@@ -114,12 +157,12 @@ func checkStruct(pass *analysis.Pass, sTyp *ast.StructType, name string, structM
 
 	// Marker present but no status field
 	if hasStatusSubresourceMarker {
-		pass.Reportf(sTyp.Pos(), "root object type %q is marked to enable the status subresource with marker %q but has no status field", name, markers.KubebuilderStatusSubresourceMarker)
+		missingStatusFieldCheck.Reportf(pass, sTyp.Pos(), "root object type %q is marked to enable the status subresource with marker %q but has no status field", name, markers.KubebuilderStatusSubresourceMarker)
 		return
 	}
 
 	// Status field present but no marker - suggest autofix
-	pass.Report(analysis.Diagnostic{
+	missingStatusMarkerCheck.Report(pass, analysis.Diagnostic{
 		Pos:     sTyp.Pos(),
 		Message: fmt.Sprintf("root object type %q has a status field but does not have the marker %q to enable the status subresource", name, markers.KubebuilderStatusSubresourceMarker),
 		SuggestedFixes: []analysis.SuggestedFix{
@@ -141,6 +184,87 @@ func checkStruct(pass *analysis.Pass, sTyp *ast.StructType, name string, structM
 	})
 }
 
+// checkScale checks that a root object enabling the scale subresource also enables the status subresource,
+// and that the paths of the scale subresource resolve to fields of the expected types.
+func checkScale(pass *analysis.Pass, typeSpec *ast.TypeSpec, sTyp *ast.StructType, structMarkers markershelper.MarkerSet) {
+	scaleMarkers := structMarkers.Get(markers.KubebuilderScaleSubresourceMarker)
+	if len(scaleMarkers) == 0 {
+		return
+	}
+
+	name := typeSpec.Name.Name
+
+	if !structMarkers.HasWithValue(formatKubeBuilderMarkerWithValue(markers.KubebuilderRootMarker, "true")) || utils.IsKubernetesListType(sTyp, name) {
+		return
+	}
+
+	if !structMarkers.Has(markers.KubebuilderStatusSubresourceMarker) {
+		scaleStatusMarkerCheck.Reportf(pass, sTyp.Pos(), "root object type %q is marked to enable the scale subresource with marker %q but does not have the marker %q to enable the status subresource", name, markers.KubebuilderScaleSubresourceMarker, markers.KubebuilderStatusSubresourceMarker)
+	}
+
+	obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+	if !ok {
+		return
+	}
+
+	for _, marker := range scaleMarkers {
+		checkScalePath(pass, sTyp, name, obj.Type(), marker, specPathArgument, []string{"spec"}, isInt32)
+		checkScalePath(pass, sTyp, name, obj.Type(), marker, statusPathArgument, []string{"status"}, isInt32)
+		checkScalePath(pass, sTyp, name, obj.Type(), marker, selectorPathArgument, []string{"spec", "status"}, isString)
+	}
+}
+
+// checkScalePath checks that the path of the scale subresource, given by the marker argument, is under one of the given
+// top level fields, and resolves to a field of the type expected by the validate function.
+// The spec and status replica paths are required, while the selector path is optional.
+func checkScalePath(pass *analysis.Pass, sTyp *ast.StructType, name string, typ types.Type, marker markershelper.Marker, argument string, parents []string, validate func(types.Type) (bool, string)) {
+	value, ok := marker.Arguments[argument]
+	path := strings.Trim(value, "\"`")
+
+	if !ok || path == "" {
+		if argument != selectorPathArgument {
+			scalePathCheck.Reportf(pass, sTyp.Pos(), "root object type %q has marker %q without the required argument %s", name, markers.KubebuilderScaleSubresourceMarker, argument)
+		}
+
+		return
+	}
+
+	segments, err := utils.ParseJSONPath(path)
+	if err != nil {
+		scalePathCheck.Reportf(pass, sTyp.Pos(), "root object type %q has scale subresource %s %q which is invalid: the path %v", name, argument, path, err)
+		return
+	}
+
+	if !slices.Contains(parents, segments[0].Name) {
+		scalePathCheck.Reportf(pass, sTyp.Pos(), "root object type %q has scale subresource %s %q which must be a path under .%s", name, argument, path, strings.Join(parents, " or ."))
+		return
+	}
+
+	fieldType, err := utils.ResolveJSONPath(typ, segments)
+	if err != nil {
+		scalePathCheck.Reportf(pass, sTyp.Pos(), "root object type %q has scale subresource %s %q which does not resolve to a field: %v", name, argument, path, err)
+		return
+	}
+
+	if valid, expected := validate(fieldType); !valid {
+		scalePathTypeCheck.Reportf(pass, sTyp.Pos(), "root object type %q has scale subresource %s %q which resolves to a value of type %s, but must be %s", name, argument, path, types.TypeString(fieldType, types.RelativeTo(pass.Pkg)), expected)
+	}
+}
+
+// isInt32 determines whether the type, or the type it points to, is an int32, as required for the replica paths.
+func isInt32(typ types.Type) (bool, string) {
+	basic, ok := utils.DerefType(typ).Underlying().(*types.Basic)
+
+	return ok && basic.Kind() == types.Int32, "int32"
+}
+
+// isString determines whether the type, or the type it points to, is a string, as required for the selector path.
+func isString(typ types.Type) (bool, string) {
+	basic, ok := utils.DerefType(typ).Underlying().(*types.Basic)
+
+	return ok && basic.Info()&types.IsString != 0, "string"
+}
+
 func hasStatusField(sTyp *ast.StructType, jsonTags extractjsontags.StructFieldTags) bool {
 	if sTyp == nil || sTyp.Fields == nil || sTyp.Fields.List == nil {
 		return false
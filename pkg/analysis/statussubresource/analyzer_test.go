@@ -20,14 +20,13 @@ import (
 
 	"golang.org/x/tools/go/analysis/analysistest"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/statussubresource"
-	"sigs.k8s.io/kube-api-linter/pkg/config"
 )
 
 func TestStatusSubresourceAnalyzer(t *testing.T) {
 	testdata := analysistest.TestData()
 	initializer := statussubresource.Initializer()
 
-	analyzer, err := initializer.Init(config.LintersConfig{})
+	analyzer, err := initializer.Init(&statussubresource.StatusSubresourceConfig{})
 	if err != nil {
 		t.Fatal(err)
 	}
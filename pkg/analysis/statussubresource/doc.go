@@ -24,5 +24,12 @@ way the linter guarantees that the right marker is added on this field.
 
 The linter will report an issue if the root object has a status field and does
 not contain the marker 'kubebuilder:subresource:status'
+
+When the root object is marked with 'kubebuilder:subresource:scale', the linter
+will report an issue if the status subresource is not also enabled, or if the
+specpath, statuspath or selectorpath arguments of the marker do not resolve to
+fields of the object. The specpath must resolve to an int32 field under .spec,
+the statuspath to an int32 field under .status, and the optional selectorpath to
+a string field under either .spec or .status.
 */
 package statussubresource
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package statussubresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/statussubresource"
+)
+
+var _ = Describe("statussubresource initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      statussubresource.StatusSubresourceConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := statussubresource.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("statussubresource"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid StatusSubresourceConfig", testCase{
+				config:      statussubresource.StatusSubresourceConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid StatusSubresourceConfig: Checks: Disable", testCase{
+				config: statussubresource.StatusSubresourceConfig{
+					Checks: checks.Config{
+						Disable: []string{"missing-status-marker"},
+					},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid StatusSubresourceConfig: Checks: unknown check", testCase{
+				config: statussubresource.StatusSubresourceConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "statussubresource.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: missing-status-field,missing-status-marker,scale-missing-status-marker,scale-path,scale-path-type",
+			}),
+		)
+	})
+})
@@ -279,3 +279,76 @@ type PreviousType struct {
 type NotOrphanedTest struct {
 	Name string `json:"name"`
 }
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+type ValidScale struct {
+	Spec   ValidScaleSpec   `json:"spec"`
+	Status ValidScaleStatus `json:"status"`
+}
+
+type ValidScaleSpec struct {
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+type ValidScaleStatus struct {
+	Replicas int32  `json:"replicas"`
+	Selector string `json:"selector,omitempty"`
+}
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+type ScaleWithoutSelector struct {
+	Spec   ValidScaleSpec   `json:"spec"`
+	Status ValidScaleStatus `json:"status"`
+}
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+type ScaleWithoutStatusMarker struct { // want "root object type \"ScaleWithoutStatusMarker\" has a status field but does not have the marker \"kubebuilder:subresource:status\" to enable the status subresource" "root object type \"ScaleWithoutStatusMarker\" is marked to enable the scale subresource with marker \"kubebuilder:subresource:scale\" but does not have the marker \"kubebuilder:subresource:status\" to enable the status subresource"
+	Spec   ValidScaleSpec   `json:"spec"`
+	Status ValidScaleStatus `json:"status"`
+}
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:statuspath=.status.replicas
+type ScaleMissingSpecPath struct { // want "root object type \"ScaleMissingSpecPath\" has marker \"kubebuilder:subresource:scale\" without the required argument specpath"
+	Spec   ValidScaleSpec   `json:"spec"`
+	Status ValidScaleStatus `json:"status"`
+}
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=spec.replicas,statuspath=.spec.replicas
+type ScaleInvalidPaths struct { // want "root object type \"ScaleInvalidPaths\" has scale subresource specpath \"spec.replicas\" which is invalid: the path must start with a '.'" "root object type \"ScaleInvalidPaths\" has scale subresource statuspath \".spec.replicas\" which must be a path under .status"
+	Spec   ValidScaleSpec   `json:"spec"`
+	Status ValidScaleStatus `json:"status"`
+}
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.size,statuspath=.status.replicas,selectorpath=.metadata.labels
+type ScaleUnresolvedPaths struct { // want "root object type \"ScaleUnresolvedPaths\" has scale subresource specpath \".spec.size\" which does not resolve to a field: .spec has no field \"size\"" "root object type \"ScaleUnresolvedPaths\" has scale subresource selectorpath \".metadata.labels\" which must be a path under .spec or .status"
+	Spec   ValidScaleSpec   `json:"spec"`
+	Status ValidScaleStatus `json:"status"`
+}
+
+// +kubebuilder:object:root:=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+type ScaleWrongTypes struct { // want "root object type \"ScaleWrongTypes\" has scale subresource specpath \".spec.replicas\" which resolves to a value of type int64, but must be int32" "root object type \"ScaleWrongTypes\" has scale subresource statuspath \".status.replicas\" which resolves to a value of type \\*int, but must be int32" "root object type \"ScaleWrongTypes\" has scale subresource selectorpath \".status.selector\" which resolves to a value of type map\\[string\\]string, but must be string"
+	Spec   ScaleWrongTypesSpec   `json:"spec"`
+	Status ScaleWrongTypesStatus `json:"status"`
+}
+
+type ScaleWrongTypesSpec struct {
+	Replicas int64 `json:"replicas"`
+}
+
+type ScaleWrongTypesStatus struct {
+	Replicas *int              `json:"replicas"`
+	Selector map[string]string `json:"selector"`
+}
@@ -22,17 +22,24 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	markersconsts "sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
 const name = "uniquemarkers"
 
+//nolint:gochecknoglobals
+var nonUniqueMarkerCheck = checks.New(name, "non-unique-marker", config.SeverityWarning)
+
 func init() {
+	checks.DefaultRegistry().Register(nonUniqueMarkerCheck)
+
 	for _, uniqueMarker := range defaultUniqueMarkers() {
 		markers.DefaultRegistry().Register(uniqueMarker.Identifier)
 	}
@@ -161,7 +168,7 @@ func constructIdentifier(marker markers.Marker, attributes ...string) string {
 
 func reportField(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string) func(id string) {
 	return func(id string) {
-		pass.Report(analysis.Diagnostic{
+		nonUniqueMarkerCheck.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf("field %s has multiple definitions of marker %s when only a single definition should exist", qualifiedFieldName, id),
 		})
@@ -170,7 +177,7 @@ func reportField(pass *analysis.Pass, field *ast.Field, qualifiedFieldName strin
 
 func reportType(pass *analysis.Pass, typeSpec *ast.TypeSpec) func(id string) {
 	return func(id string) {
-		pass.Report(analysis.Diagnostic{
+		nonUniqueMarkerCheck.Report(pass, analysis.Diagnostic{
 			Pos:     typeSpec.Pos(),
 			Message: fmt.Sprintf("type %s has multiple definitions of marker %s when only a single definition should exist", typeSpec.Name, id),
 		})
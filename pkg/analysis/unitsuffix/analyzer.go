@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package unitsuffix
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const name = "unitsuffix"
+
+//nolint:gochecknoglobals
+var (
+	missingUnitCheck    = checks.New(name, "missing-unit", config.SeverityWarning)
+	jsonTagUnitCheck    = checks.New(name, "json-tag-unit", config.SeverityWarning)
+	missingMinimumCheck = checks.New(name, "missing-minimum", config.SeverityWarning)
+
+	defaultUnitFieldNames = []string{"Timeout", "Interval", "Period", "Delay", "TTL", "Size"}
+	defaultUnitSuffixes   = []string{"Seconds", "Millis", "Minutes", "Hours", "Bytes", "KiB", "MiB", "GiB"}
+)
+
+func init() {
+	checks.DefaultRegistry().Register(missingUnitCheck, jsonTagUnitCheck, missingMinimumCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderMinimumMarker,
+		markers.K8sMinimumMarker,
+	)
+}
+
+type analyzer struct {
+	unitFieldNames []string
+	unitSuffixes   []string
+	checks         checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *UnitSuffixConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &UnitSuffixConfig{}
+	}
+
+	defaultConfig(cfg)
+
+	a := &analyzer{
+		unitFieldNames: cfg.UnitFieldNames,
+		unitSuffixes:   cfg.UnitSuffixes,
+		checks:         cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that integer fields representing durations or sizes have a unit suffix in their name and json tag, and forbid negative values.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func defaultConfig(cfg *UnitSuffixConfig) {
+	if len(cfg.UnitFieldNames) == 0 {
+		cfg.UnitFieldNames = defaultUnitFieldNames
+	}
+
+	if len(cfg.UnitSuffixes) == 0 {
+		cfg.UnitSuffixes = defaultUnitSuffixes
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	inspect.InspectFields(func(field *ast.Field, jsonTagInfo extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+		a.checkField(pass, field, jsonTagInfo, markersAccess, qualifiedFieldName)
+	})
+
+	return nil, nil //nolint:nilnil
+}
+
+func (a *analyzer) checkField(pass *analysis.Pass, field *ast.Field, jsonTagInfo extractjsontags.FieldTagInfo, markersAccess markershelper.Markers, qualifiedFieldName string) {
+	if len(field.Names) == 0 || !isIntegerType(pass, field.Type) {
+		return
+	}
+
+	fieldName := field.Names[0].Name
+
+	suffix := a.unitSuffix(fieldName)
+	if suffix == "" {
+		if word := a.unitFieldName(fieldName); word != "" {
+			missingUnitCheck.Reportf(pass, field.Pos(), "field %s appears to represent a duration or size (%s) and should have a unit suffix, one of: %s", qualifiedFieldName, word, strings.Join(a.unitSuffixes, ", "))
+		}
+
+		return
+	}
+
+	if jsonTagInfo.Name != "" && !jsonTagInfo.Ignored && !jsonTagInfo.Inline && !hasUnitSuffix(fieldName, jsonTagInfo.Name, suffix) {
+		jsonTagUnitCheck.Reportf(pass, field.Pos(), "field %s json tag %q should have the same unit suffix as the field name: %s", qualifiedFieldName, jsonTagInfo.Name, suffix)
+	}
+
+	checkMinimum(pass, field, markersAccess, qualifiedFieldName)
+}
+
+// checkMinimum reports fields with a unit suffix that do not forbid negative values with a minimum marker.
+func checkMinimum(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, qualifiedFieldName string) {
+	fieldMarkers := utils.TypeAwareMarkerCollectionForField(pass, markersAccess, field)
+
+	minimumMarkers := slices.Concat(fieldMarkers.Get(markers.KubebuilderMinimumMarker), fieldMarkers.Get(markers.K8sMinimumMarker))
+	if len(minimumMarkers) == 0 {
+		missingMinimumCheck.Reportf(pass, field.Pos(), "field %s has a unit suffix and should have a minimum marker to forbid negative values", qualifiedFieldName)
+		return
+	}
+
+	for _, marker := range minimumMarkers {
+		minimum, err := strconv.ParseFloat(marker.Payload.Value, 64)
+		if err == nil && minimum < 0 {
+			missingMinimumCheck.Reportf(pass, field.Pos(), "field %s has a unit suffix and should not allow negative values, but has a minimum of %s", qualifiedFieldName, marker.Payload.Value)
+		}
+	}
+}
+
+// unitSuffix returns the unit suffix of the field name, if any.
+// The unit suffix either ends the field name, or directly follows a word that implies a unit,
+// e.g. `Seconds` in `TTLSecondsAfterFinished`.
+func (a *analyzer) unitSuffix(fieldName string) string {
+	for _, suffix := range a.unitSuffixes {
+		if strings.HasSuffix(fieldName, suffix) {
+			return suffix
+		}
+	}
+
+	for _, word := range a.unitFieldNames {
+		for _, suffix := range a.unitSuffixes {
+			if containsWord(fieldName, word+suffix) {
+				return suffix
+			}
+		}
+	}
+
+	return ""
+}
+
+// unitFieldName returns the word within the field name that implies a unit, if any.
+func (a *analyzer) unitFieldName(fieldName string) string {
+	for _, word := range a.unitFieldNames {
+		if containsWord(fieldName, word) {
+			return word
+		}
+	}
+
+	return ""
+}
+
+// containsWord determines whether the camel case name contains the word.
+// The word must be followed by the end of the name, or the start of another word.
+func containsWord(name, word string) bool {
+	for offset := 0; offset < len(name); {
+		idx := strings.Index(name[offset:], word)
+		if idx < 0 {
+			return false
+		}
+
+		end := offset + idx + len(word)
+		if next, _ := utf8.DecodeRuneInString(name[end:]); end == len(name) || !unicode.IsLower(next) {
+			return true
+		}
+
+		offset = end
+	}
+
+	return false
+}
+
+// hasUnitSuffix determines whether the json name has the unit suffix of the field name.
+// Where the field name ends with the unit suffix, so must the json name, and where the json name is only the unit,
+// the leading letter is expected to be lower case.
+// Otherwise, the unit suffix follows a word within the field name, and the json name must contain it.
+func hasUnitSuffix(fieldName, jsonName, suffix string) bool {
+	if !strings.HasSuffix(fieldName, suffix) {
+		return strings.Contains(jsonName, suffix)
+	}
+
+	return strings.HasSuffix(jsonName, suffix) || strings.EqualFold(jsonName, suffix)
+}
+
+// isIntegerType determines whether the expression, or the type it points to, is an integer.
+func isIntegerType(pass *analysis.Pass, expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	typ := pass.TypesInfo.TypeOf(expr)
+	if typ == nil {
+		return false
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+
+	return ok && basic.Info()&types.IsInteger != 0
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package unitsuffix
+
+import "sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+
+// UnitSuffixConfig contains configuration for the unitsuffix linter.
+type UnitSuffixConfig struct {
+	// unitFieldNames is a list of words that, when present in the name of an integer field,
+	// imply that the field represents a duration or a size, and so must have a unit suffix.
+	// Words are matched at camel case word boundaries, e.g. `Timeout` matches `ReadTimeout`, but `Delay` does not match `Delays`.
+	// Each word must start with an upper case letter.
+	// When omitted, the words `Timeout`, `Interval`, `Period`, `Delay`, `TTL` and `Size` are used.
+	UnitFieldNames []string `json:"unitFieldNames"`
+
+	// unitSuffixes is the list of unit suffixes that integer durations and sizes may end with.
+	// Each suffix must start with an upper case letter.
+	// When omitted, the suffixes `Seconds`, `Millis`, `Minutes`, `Hours`, `Bytes`, `KiB`, `MiB` and `GiB` are used.
+	UnitSuffixes []string `json:"unitSuffixes"`
+
+	// checks allows the individual checks performed by the linter to be enabled or disabled.
+	// Checks are referred to by their name within the linter, e.g. `missing-unit`.
+	// All checks are enabled by default.
+	Checks checks.Config `json:"checks"`
+}
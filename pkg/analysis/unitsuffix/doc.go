@@ -0,0 +1,30 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `unitsuffix` linter checks that integer fields representing durations or sizes carry their unit in their name.
+
+The `nodurations` linter recommends that durations are represented by integer fields with a unit in the name, e.g. `FooSeconds`.
+This linter enforces the follow through, reporting integer fields whose names imply a duration or size,
+e.g. `Timeout`, `Interval`, `Period`, `Delay`, `TTL` or `Size`, but that do not have a unit suffix,
+e.g. `Seconds`, `Millis` or `Bytes`.
+The unit suffix must either end the field name, or directly follow the word that implies the unit, e.g. `TTLSecondsAfterFinished`.
+
+For integer fields that do have a unit suffix, the linter also checks that the json tag has the same suffix,
+and that a minimum marker forbids negative values.
+
+Both the words that imply a unit and the accepted unit suffixes are configurable.
+*/
+package unitsuffix
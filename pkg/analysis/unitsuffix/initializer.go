@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package unitsuffix
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
+)
+
+func init() {
+	registry.DefaultRegistry().RegisterLinter(Initializer())
+}
+
+// Initializer returns the AnalyzerInitializer for this
+// Analyzer so that it can be added to the registry.
+func Initializer() initializer.AnalyzerInitializer {
+	return initializer.NewConfigurableInitializer(
+		name,
+		initAnalyzer,
+		false,
+		validateConfig,
+	)
+}
+
+// initAnalyzer returns the initialized Analyzer.
+func initAnalyzer(cfg *UnitSuffixConfig) (*analysis.Analyzer, error) {
+	return newAnalyzer(cfg), nil
+}
+
+// validateConfig is used to validate the configuration in the UnitSuffixConfig struct.
+func validateConfig(cfg *UnitSuffixConfig, fldPath *field.Path) field.ErrorList {
+	if cfg == nil {
+		return field.ErrorList{}
+	}
+
+	fieldErrors := field.ErrorList{}
+
+	fieldErrors = append(fieldErrors, validateWords(cfg.UnitFieldNames, fldPath.Child("unitFieldNames"))...)
+	fieldErrors = append(fieldErrors, validateWords(cfg.UnitSuffixes, fldPath.Child("unitSuffixes"))...)
+	fieldErrors = append(fieldErrors, checks.ValidateConfig(cfg.Checks, name, fldPath.Child("checks"))...)
+
+	return fieldErrors
+}
+
+// validateWords validates that each word is unique and starts with an upper case letter.
+func validateWords(words []string, fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+	seen := sets.New[string]()
+
+	for i, word := range words {
+		if seen.Has(word) {
+			fieldErrors = append(fieldErrors, field.Duplicate(fldPath.Index(i), word))
+			continue
+		}
+
+		seen.Insert(word)
+
+		if r, _ := utf8.DecodeRuneInString(word); !unicode.IsUpper(r) {
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Index(i), word, "must start with an upper case letter"))
+		}
+	}
+
+	return fieldErrors
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package unitsuffix_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/initializer"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/unitsuffix"
+)
+
+var _ = Describe("unitsuffix initializer", func() {
+	Context("config validation", func() {
+		type testCase struct {
+			config      unitsuffix.UnitSuffixConfig
+			expectedErr string
+		}
+
+		DescribeTable("should validate the provided config", func(in testCase) {
+			ci, ok := unitsuffix.Initializer().(initializer.ConfigurableAnalyzerInitializer)
+			Expect(ok).To(BeTrue())
+
+			errs := ci.ValidateConfig(&in.config, field.NewPath("unitsuffix"))
+			if len(in.expectedErr) > 0 {
+				Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+			} else {
+				Expect(errs).To(HaveLen(0), "No errors were expected")
+			}
+		},
+			Entry("With a valid UnitSuffixConfig", testCase{
+				config:      unitsuffix.UnitSuffixConfig{},
+				expectedErr: "",
+			}),
+			Entry("With a valid UnitSuffixConfig: UnitFieldNames and UnitSuffixes", testCase{
+				config: unitsuffix.UnitSuffixConfig{
+					UnitFieldNames: []string{"Timeout", "Quota"},
+					UnitSuffixes:   []string{"Seconds", "Bytes"},
+				},
+				expectedErr: "",
+			}),
+			Entry("With an invalid UnitSuffixConfig: UnitFieldNames: lower case", testCase{
+				config: unitsuffix.UnitSuffixConfig{
+					UnitFieldNames: []string{"timeout"},
+				},
+				expectedErr: "unitsuffix.unitFieldNames[0]: Invalid value: \"timeout\": must start with an upper case letter",
+			}),
+			Entry("With an invalid UnitSuffixConfig: UnitSuffixes: duplicate", testCase{
+				config: unitsuffix.UnitSuffixConfig{
+					UnitSuffixes: []string{"Seconds", "Bytes", "Seconds"},
+				},
+				expectedErr: "unitsuffix.unitSuffixes[2]: Duplicate value: \"Seconds\"",
+			}),
+			Entry("With an invalid UnitSuffixConfig: UnitSuffixes: empty", testCase{
+				config: unitsuffix.UnitSuffixConfig{
+					UnitSuffixes: []string{""},
+				},
+				expectedErr: "unitsuffix.unitSuffixes[0]: Invalid value: \"\": must start with an upper case letter",
+			}),
+			Entry("With an invalid UnitSuffixConfig: Checks: unknown check", testCase{
+				config: unitsuffix.UnitSuffixConfig{
+					Checks: checks.Config{
+						Disable: []string{"unknown"},
+					},
+				},
+				expectedErr: "unitsuffix.checks.disable: Invalid value: []string{\"unknown\"}: unknown checks: unknown, must be one of: json-tag-unit,missing-minimum,missing-unit",
+			}),
+		)
+	})
+})
@@ -0,0 +1,60 @@
+package a
+
+type Durations struct {
+	// +kubebuilder:validation:Minimum=0
+	TimeoutSeconds int32 `json:"timeoutSeconds"`
+
+	// +k8s:minimum=1
+	IntervalMillis *int64 `json:"intervalMillis,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	TTLSeconds int32 `json:"ttlSeconds"`
+
+	Timeout int32 `json:"timeout"` // want "field Durations.Timeout appears to represent a duration or size \\(Timeout\\) and should have a unit suffix, one of: Seconds, Millis, Minutes, Hours, Bytes, KiB, MiB, GiB"
+
+	ReadTimeout *int64 `json:"readTimeout,omitempty"` // want "field Durations.ReadTimeout appears to represent a duration or size \\(Timeout\\) and should have a unit suffix, one of: Seconds, Millis, Minutes, Hours, Bytes, KiB, MiB, GiB"
+
+	RetryDelay int32 `json:"retryDelay"` // want "field Durations.RetryDelay appears to represent a duration or size \\(Delay\\) and should have a unit suffix, one of: Seconds, Millis, Minutes, Hours, Bytes, KiB, MiB, GiB"
+
+	TTL int32 `json:"ttl"` // want "field Durations.TTL appears to represent a duration or size \\(TTL\\) and should have a unit suffix, one of: Seconds, Millis, Minutes, Hours, Bytes, KiB, MiB, GiB"
+
+	PeriodAlias PeriodAlias `json:"periodAlias"` // want "field Durations.PeriodAlias appears to represent a duration or size \\(Period\\) and should have a unit suffix, one of: Seconds, Millis, Minutes, Hours, Bytes, KiB, MiB, GiB"
+
+	// +kubebuilder:validation:Minimum=0
+	DelaySeconds int32 `json:"delay"` // want "field Durations.DelaySeconds json tag \"delay\" should have the same unit suffix as the field name: Seconds"
+
+	PeriodSeconds int32 `json:"periodSeconds"` // want "field Durations.PeriodSeconds has a unit suffix and should have a minimum marker to forbid negative values"
+
+	// +kubebuilder:validation:Minimum=-1
+	GracePeriodSeconds int64 `json:"gracePeriodSeconds"` // want "field Durations.GracePeriodSeconds has a unit suffix and should not allow negative values, but has a minimum of -1"
+
+	// +kubebuilder:validation:Minimum=0
+	Seconds int32 `json:"seconds"`
+
+	// The minimum may also be specified on the type.
+	AliasSeconds SecondsAlias `json:"aliasSeconds"`
+
+	// Non-integer fields are not checked.
+	Timeouts []string `json:"timeouts"`
+	Interval string   `json:"interval"`
+
+	// Words must be complete to be matched.
+	Delays int32 `json:"delays"`
+
+	// The unit suffix may directly follow the word that implies the unit.
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	TimeoutSecondsOverride int32 `json:"timeoutOverride"` // want "field Durations.TimeoutSecondsOverride json tag \"timeoutOverride\" should have the same unit suffix as the field name: Seconds"
+
+	// Limits are counts, rather than durations or sizes.
+	BackoffLimit int32 `json:"backoffLimit"`
+
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+type PeriodAlias int32
+
+// +kubebuilder:validation:Minimum=0
+type SecondsAlias int64
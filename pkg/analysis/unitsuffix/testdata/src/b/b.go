@@ -0,0 +1,14 @@
+package b
+
+type Sizes struct {
+	// The default words are not matched when words are configured.
+	Timeout int32 `json:"timeout"`
+
+	Quota int64 `json:"quota"` // want "field Sizes.Quota appears to represent a duration or size \\(Quota\\) and should have a unit suffix, one of: Bytes, Kilobytes"
+
+	// +kubebuilder:validation:Minimum=0
+	QuotaKilobytes int64 `json:"quotaKilobytes"`
+
+	// The default suffixes are not matched when suffixes are configured.
+	QuotaMiB int64 `json:"quotaMiB"` // want "field Sizes.QuotaMiB appears to represent a duration or size \\(Quota\\) and should have a unit suffix, one of: Bytes, Kilobytes"
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+)
+
+// apiVersionPattern matches the names of Kubernetes API versions, such as v1, v1beta2 or v1alpha1.
+var apiVersionPattern = regexp.MustCompile(`^v([1-9][0-9]*)(?:(alpha|beta)([1-9][0-9]*))?$`)
+
+// APIVersionStability is the stability level of an API version.
+type APIVersionStability int
+
+const (
+	// APIVersionAlpha is the stability level of alpha versions, such as v1alpha1.
+	APIVersionAlpha APIVersionStability = iota
+
+	// APIVersionBeta is the stability level of beta versions, such as v1beta1.
+	APIVersionBeta
+
+	// APIVersionStable is the stability level of stable versions, such as v1.
+	APIVersionStable
+)
+
+// APIVersion is a Kubernetes API version, such as v1, v1beta2 or v1alpha1.
+type APIVersion struct {
+	// Name is the name of the version, e.g. v1beta2.
+	Name string
+
+	// Major is the major version, e.g. 1 for v1beta2.
+	Major int
+
+	// Stability is the stability level of the version, e.g. beta for v1beta2.
+	Stability APIVersionStability
+
+	// Minor is the minor version within an alpha or beta stability level, e.g. 2 for v1beta2.
+	Minor int
+}
+
+// ParseAPIVersion parses a Kubernetes API version, returning false if the name is not an API version.
+func ParseAPIVersion(name string) (APIVersion, bool) {
+	match := apiVersionPattern.FindStringSubmatch(name)
+	if match == nil {
+		return APIVersion{}, false
+	}
+
+	version := APIVersion{Name: name, Stability: APIVersionStable}
+	version.Major, _ = strconv.Atoi(match[1])
+
+	switch match[2] {
+	case "alpha":
+		version.Stability = APIVersionAlpha
+	case "beta":
+		version.Stability = APIVersionBeta
+	}
+
+	if match[3] != "" {
+		version.Minor, _ = strconv.Atoi(match[3])
+	}
+
+	return version, true
+}
+
+// NewerThan determines whether the version is newer than the other version.
+// Versions are ordered by their major version, then by their stability, and then by their minor version,
+// e.g. v1alpha1 < v1beta1 < v1beta2 < v1 < v2alpha1.
+func (v APIVersion) NewerThan(other APIVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+
+	if v.Stability != other.Stability {
+		return v.Stability > other.Stability
+	}
+
+	return v.Minor > other.Minor
+}
+
+// CompareAPIVersions compares two API versions for sorting them from the oldest to the newest.
+// It returns a negative number when a is older than b, a positive number when a is newer than b, and zero otherwise.
+func CompareAPIVersions(a, b APIVersion) int {
+	switch {
+	case a.NewerThan(b):
+		return 1
+	case b.NewerThan(a):
+		return -1
+	default:
+		return 0
+	}
+}
+
+// PassAPIVersion returns the directory of the package being analyzed, and the API version named by the directory.
+// API version packages are identified by the name of their directory, e.g. `api/apps/v1beta1`,
+// and the sibling directories within the directory of the API group hold the other versions of the group.
+// False is returned when the package is not an API version package.
+func PassAPIVersion(pass *analysis.Pass) (string, APIVersion, bool) {
+	if len(pass.Files) == 0 {
+		return "", APIVersion{}, false
+	}
+
+	dir := filepath.Dir(pass.Fset.Position(pass.Files[0].Package).Filename)
+
+	version, ok := ParseAPIVersion(filepath.Base(dir))
+
+	return dir, version, ok
+}
+
+// SiblingAPIVersions returns the API versions of the directories alongside the given API version package directory,
+// within the directory of the API group, ordered from the oldest to the newest.
+// The version of the given directory is not included.
+func SiblingAPIVersions(dir string) ([]APIVersion, error) {
+	entries, err := os.ReadDir(filepath.Dir(dir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API group directory: %w", err)
+	}
+
+	versions := []APIVersion{}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == filepath.Base(dir) {
+			continue
+		}
+
+		if version, ok := ParseAPIVersion(entry.Name()); ok {
+			versions = append(versions, version)
+		}
+	}
+
+	slices.SortFunc(versions, CompareAPIVersions)
+
+	return versions, nil
+}
+
+// APIVersionPackage holds the declarations of an API version package,
+// used to compare the types of the package with the types of other versions of the API group.
+type APIVersionPackage struct {
+	// Version is the API version of the package.
+	Version APIVersion
+
+	// TypeSpecs are the type declarations of the package, in the order they are declared.
+	TypeSpecs []*ast.TypeSpec
+
+	// Markers holds the markers of the types and fields of the package.
+	Markers markershelper.Markers
+
+	typesByName map[string]*ast.TypeSpec
+}
+
+// NewAPIVersionPackage creates an APIVersionPackage from the files of the package, and the markers extracted from them.
+func NewAPIVersionPackage(version APIVersion, files []*ast.File, markersAccess markershelper.Markers) *APIVersionPackage {
+	pkg := &APIVersionPackage{
+		Version:     version,
+		Markers:     markersAccess,
+		typesByName: make(map[string]*ast.TypeSpec),
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+					pkg.TypeSpecs = append(pkg.TypeSpecs, typeSpec)
+					pkg.typesByName[typeSpec.Name.Name] = typeSpec
+				}
+			}
+		}
+	}
+
+	return pkg
+}
+
+// LookupType returns the type declared in the package with the given name.
+func (p *APIVersionPackage) LookupType(name string) (*ast.TypeSpec, bool) {
+	typeSpec, ok := p.typesByName[name]
+	return typeSpec, ok
+}
+
+// ParseAPIVersionPackage parses the API version package in the given directory from source.
+// Test files are ignored, as they do not contribute to the API.
+// This allows the types of API versions that are not part of the current pass to be inspected.
+func ParseAPIVersionPackage(dir string, version APIVersion) (*APIVersionPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API version directory: %w", err)
+	}
+
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse API version %s: %w", version.Name, err)
+		}
+
+		files = append(files, file)
+	}
+
+	markersAccess, err := markershelper.ExtractMarkers(fset, files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract markers from API version %s: %w", version.Name, err)
+	}
+
+	return NewAPIVersionPackage(version, files, markersAccess), nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+)
+
+var _ = Describe("ParseAPIVersion", func() {
+	type parseAPIVersionInput struct {
+		name   string
+		want   utils.APIVersion
+		wantOK bool
+	}
+
+	DescribeTable("Should parse the API version", func(in parseAPIVersionInput) {
+		version, ok := utils.ParseAPIVersion(in.name)
+		Expect(ok).To(Equal(in.wantOK))
+		Expect(version).To(Equal(in.want))
+	},
+		Entry("With a stable version", parseAPIVersionInput{
+			name:   "v1",
+			want:   utils.APIVersion{Name: "v1", Major: 1, Stability: utils.APIVersionStable},
+			wantOK: true,
+		}),
+		Entry("With a beta version", parseAPIVersionInput{
+			name:   "v2beta3",
+			want:   utils.APIVersion{Name: "v2beta3", Major: 2, Stability: utils.APIVersionBeta, Minor: 3},
+			wantOK: true,
+		}),
+		Entry("With an alpha version", parseAPIVersionInput{
+			name:   "v1alpha1",
+			want:   utils.APIVersion{Name: "v1alpha1", Major: 1, Stability: utils.APIVersionAlpha, Minor: 1},
+			wantOK: true,
+		}),
+		Entry("With a version zero", parseAPIVersionInput{
+			name: "v0",
+		}),
+		Entry("With a beta version without a minor version", parseAPIVersionInput{
+			name: "v1beta",
+		}),
+		Entry("With a name that is not a version", parseAPIVersionInput{
+			name: "internal",
+		}),
+	)
+})
+
+var _ = Describe("APIVersion.NewerThan", func() {
+	// Versions in order from the oldest to the newest.
+	ordered := []string{"v1alpha1", "v1alpha2", "v1beta1", "v1beta2", "v1", "v2alpha1", "v2", "v10"}
+
+	It("Should order the versions by major version, then by stability, and then by minor version", func() {
+		for i, older := range ordered {
+			olderVersion, ok := utils.ParseAPIVersion(older)
+			Expect(ok).To(BeTrue())
+
+			for _, newer := range ordered[i+1:] {
+				newerVersion, ok := utils.ParseAPIVersion(newer)
+				Expect(ok).To(BeTrue())
+
+				Expect(newerVersion.NewerThan(olderVersion)).To(BeTrue(), "%s should be newer than %s", newer, older)
+				Expect(olderVersion.NewerThan(newerVersion)).To(BeFalse(), "%s should not be newer than %s", older, newer)
+			}
+		}
+	})
+})
+
+var _ = Describe("SiblingAPIVersions", func() {
+	It("Should return the versions of the sibling directories, from the oldest to the newest", func() {
+		groupDir := GinkgoT().TempDir()
+
+		for _, dir := range []string{"v1", "v1alpha1", "v2beta1", "v1beta1", "internal"} {
+			Expect(os.Mkdir(filepath.Join(groupDir, dir), 0o755)).To(Succeed())
+		}
+
+		Expect(os.WriteFile(filepath.Join(groupDir, "v3"), []byte{}, 0o600)).To(Succeed())
+
+		versions, err := utils.SiblingAPIVersions(filepath.Join(groupDir, "v1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		names := []string{}
+		for _, version := range versions {
+			names = append(names, version.Name)
+		}
+
+		Expect(names).To(Equal([]string{"v1alpha1", "v1beta1", "v2beta1"}))
+	})
+})
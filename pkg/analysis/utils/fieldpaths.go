@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	// ListElementPathSegment is appended to a serialized path when descending into the elements of a list.
+	ListElementPathSegment = "[*]"
+
+	// MapValuePathSegment is appended to a serialized path when descending into the values of a map.
+	MapValuePathSegment = ".*"
+)
+
+// JoinPath joins the serialized field name onto the existing serialized path.
+func JoinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+// ParseJSONTag returns the name from the json tag of a struct field, and whether the field is inline or ignored.
+// The struct field is inline when the json tag has no name and the `inline` option.
+func ParseJSONTag(tag string) (string, bool, bool) {
+	jsonTag, ok := reflect.StructTag(tag).Lookup("json")
+	if !ok {
+		return "", false, false
+	}
+
+	if jsonTag == "-" {
+		return "", false, true
+	}
+
+	tagName, options, _ := strings.Cut(jsonTag, ",")
+
+	return tagName, tagName == "" && strings.Contains(options, "inline"), false
+}
+
+// FieldsByPos returns the fields declared within the files of the pass, by the position of each of their names.
+// As the position of a struct field within the type information is the position of its name,
+// this allows the declaration of a struct field to be found from the type information,
+// where the struct field is declared within the package being analyzed.
+func FieldsByPos(pass *analysis.Pass) map[token.Pos]*ast.Field {
+	fields := map[token.Pos]*ast.Field{}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			field, ok := n.(*ast.Field)
+			if !ok {
+				return true
+			}
+
+			for _, name := range field.Names {
+				fields[name.Pos()] = field
+			}
+
+			return true
+		})
+	}
+
+	return fields
+}
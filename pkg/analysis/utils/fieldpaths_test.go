@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+)
+
+var _ = Describe("ParseJSONTag", func() {
+	type parseJSONTagInput struct {
+		tag         string
+		wantName    string
+		wantInline  bool
+		wantIgnored bool
+	}
+
+	DescribeTable("Should parse the json tag of the struct field", func(in parseJSONTagInput) {
+		name, inline, ignored := utils.ParseJSONTag(in.tag)
+		Expect(name).To(Equal(in.wantName))
+		Expect(inline).To(Equal(in.wantInline))
+		Expect(ignored).To(Equal(in.wantIgnored))
+	},
+		Entry("no json tag", parseJSONTagInput{
+			tag: `protobuf:"bytes,1,opt,name=name"`,
+		}),
+		Entry("json tag with a name", parseJSONTagInput{
+			tag:      `json:"name,omitempty"`,
+			wantName: "name",
+		}),
+		Entry("ignored json tag", parseJSONTagInput{
+			tag:         `json:"-"`,
+			wantIgnored: true,
+		}),
+		Entry("inline json tag", parseJSONTagInput{
+			tag:        `json:",inline"`,
+			wantInline: true,
+		}),
+		Entry("json tag with a name and the inline option", parseJSONTagInput{
+			tag:      `json:"name,inline"`,
+			wantName: "name",
+		}),
+	)
+})
+
+var _ = Describe("JoinPath", func() {
+	It("Should return the name when the prefix is empty", func() {
+		Expect(utils.JoinPath("", "spec")).To(Equal("spec"))
+	})
+
+	It("Should join the name onto the prefix", func() {
+		Expect(utils.JoinPath("spec.containers"+utils.ListElementPathSegment, "name")).To(Equal("spec.containers[*].name"))
+	})
+})
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+var (
+	errMustStartWithDot = errors.New("must start with a '.'")
+	errEmptySegment     = errors.New("must not contain an empty field name")
+	errUnclosedBracket  = errors.New("has an unclosed '['")
+	errUnexpectedChars  = errors.New("has unexpected characters after a subscript")
+	errNoField          = errors.New("has no field")
+	errNotObject        = errors.New("is not an object, and so has no field")
+	errNotList          = errors.New("is not a list, and so cannot be subscripted")
+)
+
+// JSONPathSegment is a segment of a JSONPath, naming a field, followed by any number of subscripts,
+// such as `[0]`, `[*]` or `[?(@.type=="Ready")]`.
+type JSONPathSegment struct {
+	// Name is the name of the field, or the key within a map.
+	Name string
+
+	// Subscripts is the number of subscripts following the name.
+	Subscripts int
+}
+
+// ParseJSONPath parses a JSONPath, as used by printer columns and the scale subresource, into its segments.
+// The JSONPaths are simple paths from the root of the object, e.g. `.status.conditions[?(@.type=="Ready")].status`.
+func ParseJSONPath(path string) ([]JSONPathSegment, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, errMustStartWithDot
+	}
+
+	segments := []JSONPathSegment{}
+	rest := path
+
+	for rest != "" {
+		rest = strings.TrimPrefix(rest, ".")
+
+		end := strings.IndexAny(rest, ".[")
+		if end < 0 {
+			end = len(rest)
+		}
+
+		segment := JSONPathSegment{Name: rest[:end]}
+		if segment.Name == "" {
+			return nil, errEmptySegment
+		}
+
+		rest = rest[end:]
+
+		for strings.HasPrefix(rest, "[") {
+			closing := closingBracket(rest)
+			if closing < 0 {
+				return nil, errUnclosedBracket
+			}
+
+			segment.Subscripts++
+			rest = rest[closing+1:]
+		}
+
+		if rest != "" && !strings.HasPrefix(rest, ".") {
+			return nil, fmt.Errorf("%w: %q", errUnexpectedChars, rest)
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// closingBracket returns the index of the bracket closing the bracket at the start of the string, or -1.
+// Brackets within quoted strings, such as within filter expressions, are ignored.
+func closingBracket(s string) int {
+	depth := 0
+
+	var quote rune
+
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// ResolveJSONPath resolves the segments of the JSONPath against the type of the object,
+// returning the type of the value that the path refers to.
+// Fields are looked up by their serialized name, including fields of inline embedded structs.
+// Subscripts descend into the elements of lists, and field names within maps descend into the values of the map.
+func ResolveJSONPath(typ types.Type, segments []JSONPathSegment) (types.Type, error) {
+	path := ""
+
+	for _, segment := range segments {
+		switch t := DerefType(typ).Underlying().(type) {
+		case *types.Struct:
+			field, ok := SerializedStructFields(t)[segment.Name]
+			if !ok {
+				return nil, fmt.Errorf("%s %w %q", describePath(path), errNoField, segment.Name)
+			}
+
+			typ = field.Type()
+		case *types.Map:
+			typ = t.Elem()
+		default:
+			return nil, fmt.Errorf("%s %w %q", describePath(path), errNotObject, segment.Name)
+		}
+
+		path += "." + segment.Name
+
+		for range segment.Subscripts {
+			switch t := DerefType(typ).Underlying().(type) {
+			case *types.Slice:
+				typ = t.Elem()
+			case *types.Array:
+				typ = t.Elem()
+			default:
+				return nil, fmt.Errorf("%s %w", path, errNotList)
+			}
+
+			path += "[]"
+		}
+	}
+
+	return typ, nil
+}
+
+// describePath describes the path within the object, for use in error messages.
+func describePath(path string) string {
+	if path == "" {
+		return "the object"
+	}
+
+	return path
+}
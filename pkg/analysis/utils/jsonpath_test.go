@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+)
+
+var _ = Describe("ParseJSONPath", func() {
+	type parseJSONPathInput struct {
+		path        string
+		want        []utils.JSONPathSegment
+		expectedErr string
+	}
+
+	DescribeTable("Should parse the JSONPath into its segments", func(in parseJSONPathInput) {
+		segments, err := utils.ParseJSONPath(in.path)
+		if in.expectedErr != "" {
+			Expect(err).To(MatchError(in.expectedErr))
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(segments).To(Equal(in.want))
+	},
+		Entry("simple path", parseJSONPathInput{
+			path: ".spec.replicas",
+			want: []utils.JSONPathSegment{{Name: "spec"}, {Name: "replicas"}},
+		}),
+		Entry("path with subscripts", parseJSONPathInput{
+			path: ".spec.containers[*].ports[0].name",
+			want: []utils.JSONPathSegment{{Name: "spec"}, {Name: "containers", Subscripts: 1}, {Name: "ports", Subscripts: 1}, {Name: "name"}},
+		}),
+		Entry("path with a filter containing dots and brackets", parseJSONPathInput{
+			path: `.status.conditions[?(@.type=="Ready[0]")].status`,
+			want: []utils.JSONPathSegment{{Name: "status"}, {Name: "conditions", Subscripts: 1}, {Name: "status"}},
+		}),
+		Entry("path without a leading dot", parseJSONPathInput{
+			path:        "spec.replicas",
+			expectedErr: "must start with a '.'",
+		}),
+		Entry("path with an empty segment", parseJSONPathInput{
+			path:        ".spec..replicas",
+			expectedErr: "must not contain an empty field name",
+		}),
+		Entry("path with an unclosed bracket", parseJSONPathInput{
+			path:        ".spec.containers[0.image",
+			expectedErr: "has an unclosed '['",
+		}),
+		Entry("path with characters after a subscript", parseJSONPathInput{
+			path:        ".spec.containers[0]image",
+			expectedErr: "has unexpected characters after a subscript: \"image\"",
+		}),
+	)
+})
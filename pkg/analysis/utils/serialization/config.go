@@ -78,6 +78,10 @@ const (
 
 // Config is the configuration for the serialization check.
 type Config struct {
+	// Linter is the name of the linter using the check.
+	// It is used to identify the checks that report diagnostics.
+	Linter string
+
 	// Pointers is the configuration for pointers.
 	Pointers PointersConfig
 
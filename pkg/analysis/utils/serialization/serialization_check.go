@@ -21,9 +21,11 @@ import (
 	"go/types"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -32,11 +34,39 @@ type SerializationCheck interface {
 	Check(pass *analysis.Pass, field *ast.Field, markersAccess markershelper.Markers, jsonTags extractjsontags.FieldTagInfo, qualifiedFieldName string)
 }
 
+// Checks returns the checks performed by the SerializationCheck on behalf of the named linter.
+// Linters using the SerializationCheck should register these checks with the checks registry.
+func Checks(linter string) []checks.Check {
+	c := newCheckSet(linter)
+
+	return []checks.Check{c.addPointer, c.removePointer, c.addOmitEmpty, c.addOmitZero, c.removeOmitZero, utils.InvalidMarkerCheck(linter)}
+}
+
+// checkSet is the set of checks performed by the SerializationCheck.
+type checkSet struct {
+	addPointer     checks.Check
+	removePointer  checks.Check
+	addOmitEmpty   checks.Check
+	addOmitZero    checks.Check
+	removeOmitZero checks.Check
+}
+
+func newCheckSet(linter string) checkSet {
+	return checkSet{
+		addPointer:     checks.New(linter, "add-pointer", config.SeverityWarning),
+		removePointer:  checks.New(linter, "remove-pointer", config.SeverityWarning),
+		addOmitEmpty:   checks.New(linter, "add-omitempty", config.SeverityWarning),
+		addOmitZero:    checks.New(linter, "add-omitzero", config.SeverityWarning),
+		removeOmitZero: checks.New(linter, "remove-omitzero", config.SeverityWarning),
+	}
+}
+
 // New creates a new SerializationCheck with the given configuration.
 func New(cfg *Config) SerializationCheck {
 	validateConfig(cfg)
 
 	return &serializationCheck{
+		checks:            newCheckSet(cfg.Linter),
 		pointerPolicy:     cfg.Pointers.Policy,
 		pointerPreference: cfg.Pointers.Preference,
 		omitEmptyPolicy:   cfg.OmitEmpty.Policy,
@@ -80,6 +110,7 @@ func validateConfig(cfg *Config) {
 
 // serializationCheck is the implementation of the SerializationCheck interface.
 type serializationCheck struct {
+	checks            checkSet
 	pointerPolicy     PointersPolicy
 	pointerPreference PointersPreference
 	omitEmptyPolicy   OmitEmptyPolicy
@@ -148,7 +179,7 @@ func (s *serializationCheck) handleFieldShouldHaveOmitEmpty(pass *analysis.Pass,
 		return
 	}
 
-	reportShouldAddOmitEmpty(pass, field, s.omitEmptyPolicy, qualifiedFieldName, "field %s should have the omitempty tag.", jsonTags)
+	reportShouldAddOmitEmpty(pass, s.checks.addOmitEmpty, field, s.omitEmptyPolicy, qualifiedFieldName, "field %s should have the omitempty tag.", jsonTags)
 }
 
 func (s *serializationCheck) checkFieldPropertiesWithOmitEmptyRequired(pass *analysis.Pass, field *ast.Field, fieldName string, jsonTags extractjsontags.FieldTagInfo, underlying ast.Expr, hasOmitEmpty, hasValidZeroValue, completeValidation, isPointer, isStruct bool, markersAccess markershelper.Markers, qualifiedFieldName string) {
@@ -187,7 +218,7 @@ func (s *serializationCheck) checkFieldPropertiesWithoutOmitEmpty(pass *analysis
 			// Force the omitempty policy to suggest a fix. We can only get to this function when the policy is configured to Ignore.
 			// Since we absolutely have to add the omitempty tag, we can report it as a suggestion.
 			// If we are checking omitzero separately, and it's a struct, this wouldn't apply so we skip.
-			reportShouldAddOmitEmpty(pass, field, OmitEmptyPolicySuggestFix, qualifiedFieldName, "field %s does not allow the zero value. It must have the omitempty tag.", jsonTags)
+			reportShouldAddOmitEmpty(pass, s.checks.addOmitEmpty, field, OmitEmptyPolicySuggestFix, qualifiedFieldName, "field %s does not allow the zero value. It must have the omitempty tag.", jsonTags)
 		}
 
 		// Once it has the omitempty tag, it will also need to be a pointer in some cases.
@@ -212,7 +243,7 @@ func (s *serializationCheck) checkFieldPropertiesWithOmitZeroForbidPolicy(pass *
 		return
 	}
 
-	reportShouldRemoveOmitZero(pass, field, qualifiedFieldName, jsonTags)
+	reportShouldRemoveOmitZero(pass, s.checks.removeOmitZero, field, qualifiedFieldName, jsonTags)
 }
 
 func (s *serializationCheck) handleFieldShouldHaveOmitZero(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string, hasOmitZero bool, jsonTags extractjsontags.FieldTagInfo) {
@@ -221,7 +252,7 @@ func (s *serializationCheck) handleFieldShouldHaveOmitZero(pass *analysis.Pass,
 	}
 
 	// Currently, add omitzero tags to only struct fields.
-	reportShouldAddOmitZero(pass, field, s.omitZeroPolicy, qualifiedFieldName, "field %s does not allow the zero value. It must have the omitzero tag.", jsonTags)
+	reportShouldAddOmitZero(pass, s.checks.addOmitZero, field, s.omitZeroPolicy, qualifiedFieldName, "field %s does not allow the zero value. It must have the omitzero tag.", jsonTags)
 }
 
 func (s *serializationCheck) handleFieldShouldBePointer(pass *analysis.Pass, field *ast.Field, fieldName string, isPointer bool, underlying ast.Expr, markersAccess markershelper.Markers, reason, qualifiedFieldName string) {
@@ -251,9 +282,9 @@ func (s *serializationCheck) handlePointerToPointerType(pass *analysis.Pass, fie
 
 	switch s.pointerPolicy {
 	case PointersPolicySuggestFix:
-		reportShouldRemovePointer(pass, field, PointersPolicySuggestFix, fieldName, "field %s underlying type does not need to be a pointer. The pointer should be removed.", qualifiedFieldName)
+		reportShouldRemovePointer(pass, s.checks.removePointer, field, PointersPolicySuggestFix, fieldName, "field %s underlying type does not need to be a pointer. The pointer should be removed.", qualifiedFieldName)
 	case PointersPolicyWarn:
-		pass.Reportf(field.Pos(), "field %s underlying type does not need to be a pointer. The pointer should be removed.", qualifiedFieldName)
+		s.checks.removePointer.Reportf(pass, field.Pos(), "field %s underlying type does not need to be a pointer. The pointer should be removed.", qualifiedFieldName)
 	}
 }
 
@@ -270,9 +301,9 @@ func (s *serializationCheck) handleNonPointerToPointerType(pass *analysis.Pass,
 func (s *serializationCheck) reportShouldAddPointerMessage(pass *analysis.Pass, field *ast.Field, fieldName, reason, qualifiedFieldName string) {
 	switch s.pointerPolicy {
 	case PointersPolicySuggestFix:
-		reportShouldAddPointer(pass, field, PointersPolicySuggestFix, fieldName, "field %s %s", qualifiedFieldName, reason)
+		reportShouldAddPointer(pass, s.checks.addPointer, field, PointersPolicySuggestFix, fieldName, "field %s %s", qualifiedFieldName, reason)
 	case PointersPolicyWarn:
-		pass.Reportf(field.Pos(), "field %s %s", qualifiedFieldName, reason)
+		s.checks.addPointer.Reportf(pass, field.Pos(), "field %s %s", qualifiedFieldName, reason)
 	}
 }
 
@@ -287,7 +318,7 @@ func (s *serializationCheck) handleFieldShouldNotBePointer(pass *analysis.Pass,
 		return
 	}
 
-	reportShouldRemovePointer(pass, field, s.pointerPolicy, fieldName, message, qualifiedFieldName)
+	reportShouldRemovePointer(pass, s.checks.removePointer, field, s.pointerPolicy, fieldName, message, qualifiedFieldName)
 }
 
 // hasExplicitZeroMinValidation checks if a field has an explicit MinItems=0 or MinProperties=0 marker.
@@ -22,15 +22,16 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 )
 
 // reportShouldAddPointer adds an analysis diagnostic that explains that a pointer should be added.
 // Where the pointer policy is suggest fix, it also adds a suggested fix to add the pointer.
-func reportShouldAddPointer(pass *analysis.Pass, field *ast.Field, pointerPolicy PointersPolicy, fieldName, messageFmt string, args ...any) {
+func reportShouldAddPointer(pass *analysis.Pass, check checks.Check, field *ast.Field, pointerPolicy PointersPolicy, fieldName, messageFmt string, args ...any) {
 	switch pointerPolicy {
 	case PointersPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		check.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf(messageFmt, args...),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -46,7 +47,7 @@ func reportShouldAddPointer(pass *analysis.Pass, field *ast.Field, pointerPolicy
 			},
 		})
 	case PointersPolicyWarn:
-		pass.Reportf(field.Pos(), messageFmt, args...)
+		check.Reportf(pass, field.Pos(), messageFmt, args...)
 	default:
 		panic(fmt.Sprintf("unknown pointer policy: %s", pointerPolicy))
 	}
@@ -54,10 +55,10 @@ func reportShouldAddPointer(pass *analysis.Pass, field *ast.Field, pointerPolicy
 
 // reportShouldRemovePointer adds an analysis diagnostic that explains that a pointer should be removed.
 // Where the pointer policy is suggest fix, it also adds a suggested fix to remove the pointer.
-func reportShouldRemovePointer(pass *analysis.Pass, field *ast.Field, pointerPolicy PointersPolicy, fieldName, messageFmt string, args ...any) {
+func reportShouldRemovePointer(pass *analysis.Pass, check checks.Check, field *ast.Field, pointerPolicy PointersPolicy, fieldName, messageFmt string, args ...any) {
 	switch pointerPolicy {
 	case PointersPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		check.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf(messageFmt, args...),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -73,17 +74,17 @@ func reportShouldRemovePointer(pass *analysis.Pass, field *ast.Field, pointerPol
 			},
 		})
 	case PointersPolicyWarn:
-		pass.Reportf(field.Pos(), messageFmt, args...)
+		check.Reportf(pass, field.Pos(), messageFmt, args...)
 	default:
 		panic(fmt.Sprintf("unknown pointer policy: %s", pointerPolicy))
 	}
 }
 
 // reportShouldAddOmitEmpty adds an analysis diagnostic that explains that an omitempty tag should be added.
-func reportShouldAddOmitEmpty(pass *analysis.Pass, field *ast.Field, omitEmptyPolicy OmitEmptyPolicy, qualifiedFieldName, messageFmt string, fieldTagInfo extractjsontags.FieldTagInfo) {
+func reportShouldAddOmitEmpty(pass *analysis.Pass, check checks.Check, field *ast.Field, omitEmptyPolicy OmitEmptyPolicy, qualifiedFieldName, messageFmt string, fieldTagInfo extractjsontags.FieldTagInfo) {
 	switch omitEmptyPolicy {
 	case OmitEmptyPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		check.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf(messageFmt, qualifiedFieldName),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -99,7 +100,7 @@ func reportShouldAddOmitEmpty(pass *analysis.Pass, field *ast.Field, omitEmptyPo
 			},
 		})
 	case OmitEmptyPolicyWarn:
-		pass.Reportf(field.Pos(), messageFmt, qualifiedFieldName)
+		check.Reportf(pass, field.Pos(), messageFmt, qualifiedFieldName)
 	case OmitEmptyPolicyIgnore:
 		// Do nothing, as the policy is to ignore the missing omitempty tag.
 	default:
@@ -108,10 +109,10 @@ func reportShouldAddOmitEmpty(pass *analysis.Pass, field *ast.Field, omitEmptyPo
 }
 
 // reportShouldAddOmitZero adds an analysis diagnostic that explains that an omitzero tag should be added.
-func reportShouldAddOmitZero(pass *analysis.Pass, field *ast.Field, omitZeroPolicy OmitZeroPolicy, qualifiedFieldName, messageFmt string, fieldTagInfo extractjsontags.FieldTagInfo) {
+func reportShouldAddOmitZero(pass *analysis.Pass, check checks.Check, field *ast.Field, omitZeroPolicy OmitZeroPolicy, qualifiedFieldName, messageFmt string, fieldTagInfo extractjsontags.FieldTagInfo) {
 	switch omitZeroPolicy {
 	case OmitZeroPolicySuggestFix:
-		pass.Report(analysis.Diagnostic{
+		check.Report(pass, analysis.Diagnostic{
 			Pos:     field.Pos(),
 			Message: fmt.Sprintf(messageFmt, qualifiedFieldName),
 			SuggestedFixes: []analysis.SuggestedFix{
@@ -127,7 +128,7 @@ func reportShouldAddOmitZero(pass *analysis.Pass, field *ast.Field, omitZeroPoli
 			},
 		})
 	case OmitZeroPolicyWarn:
-		pass.Reportf(field.Pos(), messageFmt, qualifiedFieldName)
+		check.Reportf(pass, field.Pos(), messageFmt, qualifiedFieldName)
 	case OmitZeroPolicyForbid:
 		// Do nothing, as the policy is to forbid the missing omitzero tag.
 	default:
@@ -136,10 +137,10 @@ func reportShouldAddOmitZero(pass *analysis.Pass, field *ast.Field, omitZeroPoli
 }
 
 // reportShouldRemoveOmitZero adds an analysis diagnostic that explains that an omitzero tag should be removed.
-func reportShouldRemoveOmitZero(pass *analysis.Pass, field *ast.Field, qualifiedFieldName string, jsonTags extractjsontags.FieldTagInfo) {
+func reportShouldRemoveOmitZero(pass *analysis.Pass, check checks.Check, field *ast.Field, qualifiedFieldName string, jsonTags extractjsontags.FieldTagInfo) {
 	omitZeroPos := jsonTags.Pos + token.Pos(strings.Index(jsonTags.RawValue, ",omitzero"))
 
-	pass.Report(analysis.Diagnostic{
+	check.Report(pass, analysis.Diagnostic{
 		Pos:     field.Pos(),
 		Message: fmt.Sprintf("field %s has the omitzero tag, but by policy is not allowed. The omitzero tag should be removed.", qualifiedFieldName),
 		SuggestedFixes: []analysis.SuggestedFix{
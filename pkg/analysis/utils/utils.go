@@ -21,10 +21,13 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
@@ -96,6 +99,31 @@ func IsStarExpr(expr ast.Expr) (bool, ast.Expr) {
 	return false, expr
 }
 
+// IsNamedType checks if the expression is a selector referring to the named type within the package,
+// e.g. `metav1.Time` for the package path `k8s.io/apimachinery/pkg/apis/meta/v1` and the type name `Time`.
+func IsNamedType(pass *analysis.Pass, expr ast.Expr, pkgPath, typeName string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	obj, ok := pass.TypesInfo.Uses[sel.Sel].(*types.TypeName)
+	if !ok || obj.Pkg() == nil {
+		return false
+	}
+
+	return obj.Pkg().Path() == pkgPath && obj.Name() == typeName
+}
+
+// DerefType returns the type that the pointer points to, or the type itself when it is not a pointer.
+func DerefType(typ types.Type) types.Type {
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return typ
+}
+
 // IsPointer checks if the expression is a pointer.
 func IsPointer(expr ast.Expr) bool {
 	_, ok := expr.(*ast.StarExpr)
@@ -534,6 +562,175 @@ func IsKubernetesListType(sTyp *ast.StructType, name string) bool {
 	return hasListFields(sTyp.Fields.List)
 }
 
+// FlattenStructFields flattens a struct's fields by looking for embedded structs, or pointers to structs,
+// and promoting their fields to the top level.
+// Embedded structs from other packages have no syntax within the package being analyzed,
+// so their fields are synthesized from the type information, see typesStructFields.
+// Other embedded fields are returned as they are.
+func FlattenStructFields(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+
+	flattenedFields := &ast.FieldList{}
+
+	for _, field := range fields.List {
+		tagInfo := jsonTags.FieldTags(field)
+		if len(field.Names) > 0 || tagInfo.Name != "" {
+			// Field is not embedded, it has an explicit name.
+			flattenedFields.List = append(flattenedFields.List, field)
+			continue
+		}
+
+		flattenedFields.List = append(flattenedFields.List, flattenEmbeddedField(pass, jsonTags, field)...)
+	}
+
+	return flattenedFields
+}
+
+// flattenEmbeddedField returns the fields promoted by the embedded field, or the field itself when it does not embed a struct.
+func flattenEmbeddedField(pass *analysis.Pass, jsonTags extractjsontags.StructFieldTags, field *ast.Field) []*ast.Field {
+	_, typ := IsStarExpr(field.Type)
+
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		typeSpec, ok := LookupTypeSpec(pass, typ)
+		if !ok {
+			return []*ast.Field{field}
+		}
+
+		embeddedStruct, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return []*ast.Field{field}
+		}
+
+		return FlattenStructFields(pass, jsonTags, embeddedStruct.Fields).List
+	case *ast.SelectorExpr:
+		embeddedType := pass.TypesInfo.TypeOf(typ)
+		if embeddedType == nil {
+			return []*ast.Field{field}
+		}
+
+		embeddedStruct, ok := embeddedType.Underlying().(*types.Struct)
+		if !ok {
+			return []*ast.Field{field}
+		}
+
+		return typesStructFields(embeddedStruct, field)
+	default:
+		return []*ast.Field{field}
+	}
+}
+
+// typesStructFields returns the fields of a struct type from another package,
+// promoting the fields of its embedded structs to the top level.
+// As the fields have no syntax within the package being analyzed, each field is synthesized
+// with its name, type and tag, and is positioned at the embedded field that promotes it.
+// Unexported fields are omitted, as they are not serialized.
+func typesStructFields(s *types.Struct, embeddedField *ast.Field) []*ast.Field {
+	fields := []*ast.Field{}
+
+	for i := range s.NumFields() {
+		field := s.Field(i)
+		tag := s.Tag(i)
+
+		jsonName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+		if field.Embedded() && jsonName == "" {
+			if embedded, ok := DerefType(field.Type()).Underlying().(*types.Struct); ok {
+				fields = append(fields, typesStructFields(embedded, embeddedField)...)
+				continue
+			}
+		}
+
+		if !field.Exported() {
+			continue
+		}
+
+		synthesized := &ast.Field{
+			Names: []*ast.Ident{{NamePos: embeddedField.Pos(), Name: field.Name()}},
+			Type:  &ast.Ident{NamePos: embeddedField.Pos(), Name: field.Type().String()},
+		}
+
+		if tag != "" {
+			synthesized.Tag = &ast.BasicLit{ValuePos: embeddedField.Pos(), Kind: token.STRING, Value: strconv.Quote(tag)}
+		}
+
+		fields = append(fields, synthesized)
+	}
+
+	return fields
+}
+
+// SerializedStructFields returns the fields of the struct type by their serialized JSON name.
+// Fields of embedded structs without a JSON name are included, as they are serialized inline.
+// Unlike FlattenStructFields, this uses the type information alone, rather than the syntax of the package being analyzed.
+func SerializedStructFields(s *types.Struct) map[string]*types.Var {
+	fields := map[string]*types.Var{}
+
+	for i := range s.NumFields() {
+		field := s.Field(i)
+
+		tag, _, _ := strings.Cut(reflect.StructTag(s.Tag(i)).Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+
+		if field.Embedded() && tag == "" {
+			embeddedType := field.Type()
+			if ptr, ok := types.Unalias(embeddedType).(*types.Pointer); ok {
+				embeddedType = ptr.Elem()
+			}
+
+			if embedded, ok := embeddedType.Underlying().(*types.Struct); ok {
+				for name, v := range SerializedStructFields(embedded) {
+					fields[name] = v
+				}
+			}
+
+			continue
+		}
+
+		if tag == "" {
+			tag = field.Name()
+		}
+
+		fields[tag] = field
+	}
+
+	return fields
+}
+
+// IsRootType checks if a type is a root object type.
+// A root object type is a struct that is either marked with `kubebuilder:object:root=true`,
+// or that embeds `TypeMeta`, as is the case for built-in types.
+// Kubernetes List types are not considered to be root object types.
+func IsRootType(typeSpec *ast.TypeSpec, typeMarkers markershelper.MarkerSet) bool {
+	if typeSpec == nil || typeSpec.Name == nil {
+		return false
+	}
+
+	sTyp, ok := typeSpec.Type.(*ast.StructType)
+	if !ok || sTyp.Fields == nil {
+		return false
+	}
+
+	if IsKubernetesListType(sTyp, typeSpec.Name.Name) {
+		return false
+	}
+
+	if typeMarkers.HasWithValue(markers.KubebuilderRootMarker + "=true") {
+		return true
+	}
+
+	for _, field := range sTyp.Fields.List {
+		if len(field.Names) == 0 && getFieldTypeName(field) == "TypeMeta" {
+			return true
+		}
+	}
+
+	return false
+}
+
 // hasListFields checks if the field list contains TypeMeta, ListMeta, and Items.
 func hasListFields(fields []*ast.Field) bool {
 	hasTypeMeta := false
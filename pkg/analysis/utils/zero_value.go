@@ -28,8 +28,10 @@ import (
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/utils/ptr"
 
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
 	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/markers"
 )
 
@@ -37,6 +39,13 @@ var (
 	errMarkerMissingValue = errors.New("marker does not have a value")
 )
 
+// InvalidMarkerCheck returns the check reported by IsZeroValueValid, on behalf of the named linter,
+// when a validation marker on a field cannot be parsed.
+// Linters using IsZeroValueValid should register this check with the checks registry.
+func InvalidMarkerCheck(linter string) checks.Check {
+	return checks.New(linter, "invalid-marker", config.SeverityWarning)
+}
+
 // IsZeroValueValid determines whether the zero value of the field is valid per the validation markers.
 // For example, if the string has a minimum length greater than 0, the zero value is not valid.
 // Or if the minimum value of an integer field is greater than 0, the zero value is not valid.
@@ -123,7 +132,7 @@ func isExternalStructZeroValueValid(pass *analysis.Pass, field *ast.Field, struc
 
 	minProperties, err := GetMinProperties(fieldMarkers)
 	if err != nil {
-		pass.Reportf(field.Pos(), "struct %s has an invalid minProperties marker: %v", FieldName(field), err)
+		InvalidMarkerCheck(pass.Analyzer.Name).Reportf(pass, field.Pos(), "struct %s has an invalid minProperties marker: %v", FieldName(field), err)
 		return false, false
 	}
 
@@ -267,7 +276,7 @@ func isStructZeroValueValid(pass *analysis.Pass, field *ast.Field, structType *a
 func checkStructMinProperties(pass *analysis.Pass, field *ast.Field, markerSet markershelper.MarkerSet, structType *ast.StructType, markersAccess markershelper.Markers, nonOmittedFields int) (bool, bool) {
 	minProperties, err := GetMinProperties(markerSet)
 	if err != nil {
-		pass.Reportf(field.Pos(), "struct %s has an invalid minProperties marker: %v", FieldName(field), err)
+		InvalidMarkerCheck(pass.Analyzer.Name).Reportf(pass, field.Pos(), "struct %s has an invalid minProperties marker: %v", FieldName(field), err)
 		return false, false
 	}
 
@@ -434,13 +443,13 @@ func isNumericZeroValueValid[N number](pass *analysis.Pass, field *ast.Field, ma
 
 	minimum, err := getMarkerNumericValueByName[N](fieldMarkers, markers.KubebuilderMinimumMarker)
 	if err != nil && !errors.Is(err, errMarkerMissingValue) {
-		pass.Reportf(field.Pos(), "field %s has an invalid minimum marker: %v", qualifiedFieldName, err)
+		InvalidMarkerCheck(pass.Analyzer.Name).Reportf(pass, field.Pos(), "field %s has an invalid minimum marker: %v", qualifiedFieldName, err)
 		return false, false
 	}
 
 	maximum, err := getMarkerNumericValueByName[N](fieldMarkers, markers.KubebuilderMaximumMarker)
 	if err != nil && !errors.Is(err, errMarkerMissingValue) {
-		pass.Reportf(field.Pos(), "field %s has an invalid maximum marker: %v", qualifiedFieldName, err)
+		InvalidMarkerCheck(pass.Analyzer.Name).Reportf(pass, field.Pos(), "field %s has an invalid maximum marker: %v", qualifiedFieldName, err)
 		return false, false
 	}
 
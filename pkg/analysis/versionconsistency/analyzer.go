@@ -0,0 +1,277 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package versionconsistency
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "versionconsistency"
+
+	// ignoreMarker excludes a field from the comparison between versions,
+	// e.g. when a field has intentionally been removed from a newer version.
+	ignoreMarker = "kal:versionconsistency:ignore"
+)
+
+//nolint:gochecknoglobals
+var (
+	missingFieldCheck     = checks.New(name, "missing-field", config.SeverityError)
+	looserBoundCheck      = checks.New(name, "looser-bound", config.SeverityError)
+	droppedEnumValueCheck = checks.New(name, "dropped-enum-value", config.SeverityError)
+
+	// bounds are the validation bounds compared between versions.
+	// The kubebuilder and declarative validation markers for the same bound are treated as equivalent.
+	bounds = []bound{
+		{name: "minimum", markers: []string{markers.KubebuilderMinimumMarker, markers.K8sMinimumMarker}},
+		{name: "maximum", markers: []string{markers.KubebuilderMaximumMarker, markers.K8sMaximumMarker}, upper: true},
+		{name: "minimum length", markers: []string{markers.KubebuilderMinLengthMarker, markers.K8sMinLengthMarker}},
+		{name: "maximum length", markers: []string{markers.KubebuilderMaxLengthMarker, markers.K8sMaxLengthMarker}, upper: true},
+		{name: "minimum number of items", markers: []string{markers.KubebuilderMinItemsMarker, markers.K8sMinItemsMarker}},
+		{name: "maximum number of items", markers: []string{markers.KubebuilderMaxItemsMarker, markers.K8sMaxItemsMarker}, upper: true},
+		{name: "minimum number of properties", markers: []string{markers.KubebuilderMinPropertiesMarker}},
+		{name: "maximum number of properties", markers: []string{markers.KubebuilderMaxPropertiesMarker}, upper: true},
+	}
+)
+
+// bound is a validation bound, such as a minimum or a maximum length, that may be set by any of its markers.
+type bound struct {
+	name    string
+	markers []string
+
+	// upper is true when the bound is an upper bound, and so is looser when it is higher.
+	upper bool
+}
+
+// value returns the value of the bound within the marker set, and whether the bound is set.
+func (b bound) value(markerSet markershelper.MarkerSet) (string, float64, bool) {
+	for _, identifier := range b.markers {
+		for _, marker := range markerSet.Get(identifier) {
+			raw := strings.Trim(marker.Payload.Value, `"`)
+
+			if value, err := strconv.ParseFloat(raw, 64); err == nil {
+				return raw, value, true
+			}
+		}
+	}
+
+	return "", 0, false
+}
+
+func init() {
+	checks.DefaultRegistry().Register(missingFieldCheck, looserBoundCheck, droppedEnumValueCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderRootMarker,
+		markers.KubebuilderEnumMarker,
+		ignoreMarker,
+	)
+
+	for _, b := range bounds {
+		markershelper.DefaultRegistry().Register(b.markers...)
+	}
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *VersionConsistencyConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &VersionConsistencyConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks that the root types of an API version package are consistent with the same Kind in the previous API version of the group, reporting fields missing from the newer version, validation bounds that are looser in the newer version and enum values dropped from the newer version.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{markershelper.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	markersAccess, ok := pass.ResultOf[markershelper.Analyzer].(markershelper.Markers)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetMarkers
+	}
+
+	dir, version, ok := utils.PassAPIVersion(pass)
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	previous, err := previousVersion(dir, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	c := &comparison{
+		pass:     pass,
+		current:  utils.NewAPIVersionPackage(version, pass.Files, markersAccess),
+		previous: previous,
+		compared: sets.New[[2]*ast.StructType](),
+	}
+
+	for _, typeSpec := range c.current.TypeSpecs {
+		previousTypeSpec, ok := previous.LookupType(typeSpec.Name.Name)
+		if !ok || !utils.IsRootType(typeSpec, markersAccess.TypeMarkers(typeSpec)) || !utils.IsRootType(previousTypeSpec, previous.Markers.TypeMarkers(previousTypeSpec)) {
+			continue
+		}
+
+		currentStruct, currentOK := typeSpec.Type.(*ast.StructType)
+		previousStruct, previousOK := previousTypeSpec.Type.(*ast.StructType)
+
+		if currentOK && previousOK {
+			c.compareStructs(currentStruct, previousStruct, typeSpec.Name.Name)
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// comparison compares the types of the API version being analyzed with the types of the previous API version.
+type comparison struct {
+	pass     *analysis.Pass
+	current  *utils.APIVersionPackage
+	previous *utils.APIVersionPackage
+
+	// compared holds the pairs of structs that have been compared, so that structs reachable
+	// through several fields are only compared once.
+	compared sets.Set[[2]*ast.StructType]
+}
+
+// compareStructs compares the fields of a struct in the current version with the fields of the
+// equivalent struct in the previous version, recursing into the structs of fields present in both.
+func (c *comparison) compareStructs(current, previous *ast.StructType, path string) {
+	if c.compared.Has([2]*ast.StructType{current, previous}) {
+		return
+	}
+
+	c.compared.Insert([2]*ast.StructType{current, previous})
+
+	currentFields := make(map[string]*ast.Field)
+	for _, field := range serializedFields(c.current, current) {
+		currentFields[field.name] = field.field
+	}
+
+	for _, previousField := range serializedFields(c.previous, previous) {
+		previousMarkers := fieldMarkers(c.previous, previousField.field)
+		if previousMarkers.Has(ignoreMarker) {
+			continue
+		}
+
+		fieldPath := path + "." + previousField.name
+
+		currentField, ok := currentFields[previousField.name]
+		if !ok {
+			missingFieldCheck.Reportf(c.pass, current.Pos(), "field %s is present in %s but missing from %s", fieldPath, c.previous.Version.Name, c.current.Version.Name)
+			continue
+		}
+
+		currentMarkers := fieldMarkers(c.current, currentField)
+		if currentMarkers.Has(ignoreMarker) {
+			continue
+		}
+
+		c.compareBounds(currentField, currentMarkers, previousMarkers, fieldPath)
+		c.compareEnums(currentField, currentMarkers, previousMarkers, fieldPath)
+
+		currentStruct, previousStruct := structType(c.current, currentField.Type), structType(c.previous, previousField.field.Type)
+		if currentStruct != nil && previousStruct != nil {
+			c.compareStructs(currentStruct, previousStruct, fieldPath)
+		}
+	}
+}
+
+// compareBounds reports bounds of the field that are looser in the current version than in the previous version.
+// A bound that is set in the previous version but not in the current version is looser.
+func (c *comparison) compareBounds(field *ast.Field, currentMarkers, previousMarkers markershelper.MarkerSet, path string) {
+	for _, b := range bounds {
+		previousRaw, previousValue, ok := b.value(previousMarkers)
+		if !ok {
+			continue
+		}
+
+		currentRaw, currentValue, ok := b.value(currentMarkers)
+
+		switch {
+		case !ok:
+			looserBoundCheck.Reportf(c.pass, field.Pos(), "field %s has a %s of %s in %s, but no %s in %s", path, b.name, previousRaw, c.previous.Version.Name, b.name, c.current.Version.Name)
+		case (b.upper && currentValue > previousValue) || (!b.upper && currentValue < previousValue):
+			looserBoundCheck.Reportf(c.pass, field.Pos(), "field %s has a %s of %s in %s, which is looser than the %s of %s in %s", path, b.name, currentRaw, c.current.Version.Name, b.name, previousRaw, c.previous.Version.Name)
+		}
+	}
+}
+
+// compareEnums reports values of the enum of the field in the previous version that are not allowed by the enum in the current version.
+// When the field has no enum in the current version, all values are allowed, and so no values have been dropped.
+func (c *comparison) compareEnums(field *ast.Field, currentMarkers, previousMarkers markershelper.MarkerSet, path string) {
+	currentValues := enumValues(currentMarkers)
+	if len(currentValues) == 0 {
+		return
+	}
+
+	dropped := []string{}
+
+	for _, value := range enumValues(previousMarkers) {
+		if !slices.Contains(currentValues, value) {
+			dropped = append(dropped, fmt.Sprintf("%q", value))
+		}
+	}
+
+	if len(dropped) > 0 {
+		droppedEnumValueCheck.Reportf(c.pass, field.Pos(), "field %s allows the enum values %s in %s, but not in %s", path, strings.Join(dropped, ", "), c.previous.Version.Name, c.current.Version.Name)
+	}
+}
+
+// enumValues returns the values allowed by the enum markers within the marker set.
+func enumValues(markerSet markershelper.MarkerSet) []string {
+	values := []string{}
+
+	for _, marker := range markerSet.Get(markers.KubebuilderEnumMarker) {
+		for _, value := range strings.Split(marker.Payload.Value, ";") {
+			if value = strings.Trim(strings.TrimSpace(value), `"`); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
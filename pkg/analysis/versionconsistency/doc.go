@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `versionconsistency` linter checks that the root types of an API version package are consistent
+with the same Kind in the previous API version of the group.
+
+Version packages are identified by the name of their directory, e.g. `v1alpha1`, `v1beta1` or `v1`,
+and the versions of a group are the sibling version directories within the group directory.
+Versions are ordered by their major version, then by their stability, and then by their minor version,
+e.g. `v1alpha1` < `v1beta1` < `v1beta2` < `v1` < `v2alpha1`.
+The root types of each version are compared with the root types of the same name in the previous version.
+
+Fields are matched by their serialized name, including the fields of inline embedded structs,
+and the structs of matching fields are compared recursively.
+
+The linter reports:
+- Fields present in the previous version but missing from the newer version.
+- Bounds, such as a minimum, a maximum length or a maximum number of items, that are looser in the newer version,
+or that are set in the previous version but not in the newer version.
+- Enum values allowed in the previous version that are not allowed in the newer version.
+
+Fields that intentionally differ between versions can be excluded from the comparison
+by marking the field, in either version, with the `+kal:versionconsistency:ignore` marker.
+*/
+package versionconsistency
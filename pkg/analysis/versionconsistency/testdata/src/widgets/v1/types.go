@@ -0,0 +1,65 @@
+package v1
+
+type TypeMeta struct {
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+type ObjectMeta struct {
+	Name string `json:"name,omitempty"`
+}
+
+// Legacy was removed in v1beta1, which is the previous version, and so is not reported again.
+// +kubebuilder:object:root=true
+type Widget struct {
+	TypeMeta `json:",inline"`
+	Metadata ObjectMeta   `json:"metadata,omitempty"`
+	Spec     WidgetSpec   `json:"spec"`
+	Status   WidgetStatus `json:"status,omitempty"`
+}
+
+type WidgetSpec struct { // want "field Widget.spec.description is present in v1beta1 but missing from v1"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	DisplayName string `json:"displayName"` // want "field Widget.spec.displayName has a maximum length of 128 in v1, which is looser than the maximum length of 63 in v1beta1"
+
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"` // want "field Widget.spec.replicas has a minimum of 0 in v1, which is looser than the minimum of 1 in v1beta1" "field Widget.spec.replicas has a maximum of 10 in v1beta1, but no maximum in v1"
+
+	// The kubebuilder marker is equivalent to the declarative validation marker in v1beta1.
+	// +kubebuilder:validation:MaxItems=5
+	Tags []string `json:"tags,omitempty"`
+
+	Color Color `json:"color"` // want "field Widget.spec.color allows the enum values \"Blue\" in v1beta1, but not in v1"
+
+	// +kubebuilder:validation:Enum=Small;Medium;Large;ExtraLarge
+	Size string `json:"size"`
+
+	Template WidgetTemplate `json:"template"`
+
+	// Owner was inline in v1beta1, and so is still serialized as the same field.
+	Owner string `json:"owner,omitempty"`
+
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Red;Green
+type Color string
+
+type WidgetTemplate struct { // want "field Widget.spec.template.image is present in v1beta1 but missing from v1"
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +kubebuilder:validation:MaxProperties=20
+	// +kal:versionconsistency:ignore
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type WidgetStatus struct {
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+// Gadget is new in v1, and so has no previous version to be compared with.
+// +kubebuilder:object:root=true
+type Gadget struct {
+	Name string `json:"name"`
+}
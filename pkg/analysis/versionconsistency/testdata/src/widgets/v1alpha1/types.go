@@ -0,0 +1,15 @@
+package v1alpha1
+
+// +kubebuilder:object:root=true
+type Widget struct {
+	Spec   WidgetSpec `json:"spec"`
+	Legacy string     `json:"legacy,omitempty"`
+}
+
+type WidgetSpec struct {
+	DisplayName string `json:"displayName"`
+
+	Replicas int32 `json:"replicas"`
+
+	Size string `json:"size"`
+}
@@ -0,0 +1,73 @@
+package v1beta1
+
+type TypeMeta struct {
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+type ObjectMeta struct {
+	Name string `json:"name,omitempty"`
+}
+
+// Legacy has been removed since v1alpha1.
+// +kubebuilder:object:root=true
+type Widget struct { // want "field Widget.legacy is present in v1alpha1 but missing from v1beta1"
+	TypeMeta `json:",inline"`
+	Metadata ObjectMeta   `json:"metadata,omitempty"`
+	Spec     WidgetSpec   `json:"spec"`
+	Status   WidgetStatus `json:"status,omitempty"`
+}
+
+type WidgetSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	DisplayName string `json:"displayName"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	Replicas int32 `json:"replicas"`
+
+	// +k8s:maxItems=5
+	Tags []string `json:"tags,omitempty"`
+
+	Color Color `json:"color"`
+
+	// +kubebuilder:validation:Enum=Small;Medium;Large
+	Size string `json:"size"`
+
+	Template WidgetTemplate `json:"template"`
+
+	// Experimental is intentionally removed in v1.
+	// +kal:versionconsistency:ignore
+	Experimental string `json:"experimental,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=256
+	Description string `json:"description,omitempty"`
+
+	WidgetCommon `json:",inline"`
+}
+
+// +kubebuilder:validation:Enum=Red;Green;Blue
+type Color string
+
+type WidgetTemplate struct {
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +kubebuilder:validation:MaxProperties=10
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	Image string `json:"image"`
+}
+
+type WidgetCommon struct {
+	Owner string `json:"owner,omitempty"`
+}
+
+type WidgetStatus struct {
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+// Gadget is not a root type, and so is not compared with other versions.
+type Gadget struct {
+	Name string `json:"name"`
+}
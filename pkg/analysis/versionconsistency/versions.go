@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package versionconsistency
+
+import (
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"slices"
+
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/extractjsontags"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+)
+
+// previousVersion returns the newest sibling API version older than the given version, parsed from source.
+// When there is no older version, nil is returned.
+func previousVersion(dir string, version utils.APIVersion) (*utils.APIVersionPackage, error) {
+	siblings, err := utils.SiblingAPIVersions(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the previous API version: %w", err)
+	}
+
+	// Siblings are ordered from the oldest to the newest.
+	for _, sibling := range slices.Backward(siblings) {
+		if !version.NewerThan(sibling) {
+			continue
+		}
+
+		pkg, err := utils.ParseAPIVersionPackage(filepath.Join(filepath.Dir(dir), sibling.Name), sibling)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the previous API version: %w", err)
+		}
+
+		return pkg, nil
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// serializedField is a field of a struct, together with the name it is serialized with.
+type serializedField struct {
+	name  string
+	field *ast.Field
+}
+
+// serializedFields returns the fields of the struct that are serialized, in the order they are declared.
+// The fields of embedded structs declared in the package are included in place of the embedded field.
+func serializedFields(pkg *utils.APIVersionPackage, sTyp *ast.StructType) []serializedField {
+	fields := []serializedField{}
+
+	if sTyp.Fields == nil {
+		return fields
+	}
+
+	for _, field := range sTyp.Fields.List {
+		tagInfo := extractjsontags.ExtractFieldTagInfo(field)
+
+		switch {
+		case tagInfo.Inline || (tagInfo.Missing && len(field.Names) == 0):
+			if embedded := structType(pkg, field.Type); embedded != nil {
+				fields = append(fields, serializedFields(pkg, embedded)...)
+			}
+		case tagInfo.Name != "" && !tagInfo.Ignored:
+			fields = append(fields, serializedField{name: tagInfo.Name, field: field})
+		}
+	}
+
+	return fields
+}
+
+// structType returns the struct that the type expression refers to, following pointers, and the elements
+// of slices and maps, or nil when the type does not refer to a struct declared in the package.
+func structType(pkg *utils.APIVersionPackage, expr ast.Expr) *ast.StructType {
+	switch typ := expr.(type) {
+	case *ast.StructType:
+		return typ
+	case *ast.StarExpr:
+		return structType(pkg, typ.X)
+	case *ast.ArrayType:
+		return structType(pkg, typ.Elt)
+	case *ast.MapType:
+		return structType(pkg, typ.Value)
+	case *ast.Ident:
+		if typeSpec, ok := pkg.LookupType(typ.Name); ok {
+			return structType(pkg, typeSpec.Type)
+		}
+	}
+
+	return nil
+}
+
+// fieldMarkers returns the markers of the field, together with the markers of the type of the field
+// when the type is declared in the package, as the markers of the type apply to the field.
+func fieldMarkers(pkg *utils.APIVersionPackage, field *ast.Field) markershelper.MarkerSet {
+	fieldMarkers := markershelper.NewMarkerSet(pkg.Markers.FieldMarkers(field).UnsortedList()...)
+
+	typ := field.Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+
+	if ident, ok := typ.(*ast.Ident); ok {
+		if typeSpec, ok := pkg.LookupType(ident.Name); ok {
+			fieldMarkers.Insert(pkg.Markers.TypeMarkers(typeSpec).UnsortedList()...)
+		}
+	}
+
+	return fieldMarkers
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package versionmarkers
+
+import (
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	kalerrors "sigs.k8s.io/kube-api-linter/pkg/analysis/errors"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
+	markershelper "sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/markers"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/utils"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/markers"
+)
+
+const (
+	name = "versionmarkers"
+
+	warningArgument = "warning"
+)
+
+//nolint:gochecknoglobals
+var (
+	missingStorageVersionCheck   = checks.New(name, "missing-storage-version", config.SeverityError)
+	multipleStorageVersionsCheck = checks.New(name, "multiple-storage-versions", config.SeverityError)
+	alphaStorageVersionCheck     = checks.New(name, "alpha-storage-version", config.SeverityWarning)
+	unservedStorageVersionCheck  = checks.New(name, "unserved-storage-version", config.SeverityError)
+	deprecationWarningCheck      = checks.New(name, "deprecation-warning", config.SeverityWarning)
+)
+
+func init() {
+	checks.DefaultRegistry().Register(missingStorageVersionCheck, multipleStorageVersionsCheck, alphaStorageVersionCheck, unservedStorageVersionCheck, deprecationWarningCheck)
+
+	markershelper.DefaultRegistry().Register(
+		markers.KubebuilderRootMarker,
+		markers.KubebuilderStorageVersionMarker,
+		markers.KubebuilderUnservedVersionMarker,
+		markers.KubebuilderDeprecatedVersionMarker,
+	)
+}
+
+type analyzer struct {
+	checks checks.Config
+}
+
+// newAnalyzer creates a new analyzer with the given configuration.
+func newAnalyzer(cfg *VersionMarkersConfig) *analysis.Analyzer {
+	if cfg == nil {
+		cfg = &VersionMarkersConfig{}
+	}
+
+	a := &analyzer{
+		checks: cfg.Checks,
+	}
+
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "Checks the storage version and deprecated version markers of each Kind across the API version packages of a group. Exactly one version of a Kind with multiple versions must be the storage version, the storage version should not be an alpha version when a stable version exists, nor an unserved version, and deprecated versions should have a warning.",
+		Run:      a.run,
+		Requires: []*analysis.Analyzer{inspector.Analyzer},
+	}
+}
+
+func (a *analyzer) run(pass *analysis.Pass) (any, error) {
+	pass = a.checks.Filter(pass)
+
+	inspect, ok := pass.ResultOf[inspector.Analyzer].(inspector.Inspector)
+	if !ok {
+		return nil, kalerrors.ErrCouldNotGetInspector
+	}
+
+	rootTypes := []rootType{}
+
+	inspect.InspectTypeSpec(func(typeSpec *ast.TypeSpec, markersAccess markershelper.Markers) {
+		typeMarkers := markersAccess.TypeMarkers(typeSpec)
+		if !utils.IsRootType(typeSpec, typeMarkers) {
+			return
+		}
+
+		checkUnservedStorageVersion(pass, typeSpec, typeMarkers)
+		checkDeprecationWarning(pass, typeSpec, typeMarkers)
+
+		rootTypes = append(rootTypes, rootType{typeSpec: typeSpec, markers: typeMarkers})
+	})
+
+	dir, version, ok := utils.PassAPIVersion(pass)
+	if !ok || len(rootTypes) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	siblings, err := loadSiblingVersions(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, root := range rootTypes {
+		checkStorageVersion(pass, root, version, kindVersions(root, version, siblings))
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// rootType is a root type of the package being analyzed, together with its markers.
+type rootType struct {
+	typeSpec *ast.TypeSpec
+	markers  markershelper.MarkerSet
+}
+
+// kindVersion is a version of a Kind, together with the markers of the root type of the Kind in that version.
+type kindVersion struct {
+	version utils.APIVersion
+	markers markershelper.MarkerSet
+}
+
+// kindVersions returns the versions of the Kind of the root type, including the version being analyzed,
+// ordered from the oldest to the newest.
+func kindVersions(root rootType, version utils.APIVersion, siblings []*utils.APIVersionPackage) []kindVersion {
+	versions := []kindVersion{{version: version, markers: root.markers}}
+
+	for _, sibling := range siblings {
+		typeSpec, ok := sibling.LookupType(root.typeSpec.Name.Name)
+		if ok && utils.IsRootType(typeSpec, sibling.Markers.TypeMarkers(typeSpec)) {
+			versions = append(versions, kindVersion{version: sibling.Version, markers: sibling.Markers.TypeMarkers(typeSpec)})
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b kindVersion) int {
+		return utils.CompareAPIVersions(a.version, b.version)
+	})
+
+	return versions
+}
+
+// loadSiblingVersions parses the sibling API version packages of the package directory from source.
+func loadSiblingVersions(dir string) ([]*utils.APIVersionPackage, error) {
+	versions, err := utils.SiblingAPIVersions(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the sibling API versions: %w", err)
+	}
+
+	siblings := []*utils.APIVersionPackage{}
+
+	for _, version := range versions {
+		sibling, err := utils.ParseAPIVersionPackage(filepath.Join(filepath.Dir(dir), version.Name), version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the sibling API versions: %w", err)
+		}
+
+		siblings = append(siblings, sibling)
+	}
+
+	return siblings, nil
+}
+
+// checkStorageVersion checks the storage version markers of the versions of the Kind, ordered from the oldest to the newest.
+// Issues concerning the storage version marker are reported in each version that has the marker,
+// while a missing storage version is reported only in the newest version of the Kind, so that it is reported once.
+func checkStorageVersion(pass *analysis.Pass, root rootType, version utils.APIVersion, kindVersions []kindVersion) {
+	if len(kindVersions) < 2 {
+		// A Kind with a single version is always stored in that version.
+		return
+	}
+
+	kind := root.typeSpec.Name.Name
+	versionNames := []string{}
+	storageVersionNames := []string{}
+	stableVersionNames := []string{}
+
+	for _, kindVersion := range kindVersions {
+		versionNames = append(versionNames, kindVersion.version.Name)
+
+		if kindVersion.markers.Has(markers.KubebuilderStorageVersionMarker) {
+			storageVersionNames = append(storageVersionNames, kindVersion.version.Name)
+		}
+
+		if kindVersion.version.Stability == utils.APIVersionStable {
+			stableVersionNames = append(stableVersionNames, kindVersion.version.Name)
+		}
+	}
+
+	newest := kindVersions[len(kindVersions)-1].version
+
+	if len(storageVersionNames) == 0 && newest == version {
+		missingStorageVersionCheck.Reportf(pass, root.typeSpec.Pos(), "Kind %s has the versions %s, but none of them is marked as the storage version with the marker %q. Exactly one version must be the storage version", kind, strings.Join(versionNames, ", "), markers.KubebuilderStorageVersionMarker)
+	}
+
+	if !root.markers.Has(markers.KubebuilderStorageVersionMarker) {
+		return
+	}
+
+	if len(storageVersionNames) > 1 {
+		multipleStorageVersionsCheck.Reportf(pass, root.typeSpec.Pos(), "Kind %s is marked as the storage version in the versions %s. Exactly one version must be the storage version", kind, strings.Join(storageVersionNames, ", "))
+	}
+
+	if version.Stability == utils.APIVersionAlpha && len(stableVersionNames) > 0 {
+		alphaStorageVersionCheck.Reportf(pass, root.typeSpec.Pos(), "Kind %s is stored in the alpha version %s, but has the stable version %s. The storage version should be a stable version", kind, version.Name, strings.Join(stableVersionNames, ", "))
+	}
+}
+
+// checkUnservedStorageVersion checks that the storage version of a Kind is served.
+// Objects are persisted in the storage version, and so it must be served, even when the Kind has a single version.
+func checkUnservedStorageVersion(pass *analysis.Pass, typeSpec *ast.TypeSpec, typeMarkers markershelper.MarkerSet) {
+	if !typeMarkers.Has(markers.KubebuilderStorageVersionMarker) || !typeMarkers.Has(markers.KubebuilderUnservedVersionMarker) {
+		return
+	}
+
+	unservedStorageVersionCheck.Reportf(pass, typeSpec.Pos(), "Kind %s is marked as the storage version with the marker %q, but is not served, as it is marked with the marker %q. The storage version must be served", typeSpec.Name.Name, markers.KubebuilderStorageVersionMarker, markers.KubebuilderUnservedVersionMarker)
+}
+
+// checkDeprecationWarning checks that deprecated versions of a Kind have a warning, that tells users of the deprecated version
+// which version to use instead.
+func checkDeprecationWarning(pass *analysis.Pass, typeSpec *ast.TypeSpec, typeMarkers markershelper.MarkerSet) {
+	for _, marker := range typeMarkers.Get(markers.KubebuilderDeprecatedVersionMarker) {
+		if strings.Trim(marker.Arguments[warningArgument], "\"`") != "" {
+			continue
+		}
+
+		deprecationWarningCheck.Reportf(pass, typeSpec.Pos(), "Kind %s is marked as a deprecated version with the marker %q, but has no warning. Set the warning, e.g. %s:%s=\"...\", to tell users which version to use instead", typeSpec.Name.Name, markers.KubebuilderDeprecatedVersionMarker, markers.KubebuilderDeprecatedVersionMarker, warningArgument)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+/*
+The `versionmarkers` linter checks the storage version and deprecated version markers of each Kind
+across the API version packages of a group.
+
+Version packages are identified by the name of their directory, e.g. `v1alpha1`, `v1beta1` or `v1`,
+and the versions of a Kind are the sibling version directories, within the group directory,
+that declare a root type with the name of the Kind.
+
+When a Kind has multiple versions, the CRD generated for the Kind must have exactly one storage version,
+marked with `+kubebuilder:storageversion`. The linter reports:
+- Kinds with multiple versions where no version is the storage version. This is reported only in the newest version of the Kind.
+- Kinds where more than one version is the storage version. This is reported in each version marked as the storage version.
+- Kinds stored in an alpha version, when a stable version of the Kind exists.
+
+Versions that are no longer served should be marked with `+kubebuilder:unservedversion`, and are still counted as versions of the Kind.
+The storage version must be served, and so the linter reports versions marked as both the storage version and an unserved version.
+Versions that are deprecated should be marked with `+kubebuilder:deprecatedversion:warning="..."`.
+The linter reports deprecated versions without a warning, as the warning tells users of the deprecated version which version to use instead.
+*/
+package versionmarkers
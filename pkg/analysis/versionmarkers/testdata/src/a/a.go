@@ -0,0 +1,34 @@
+package a
+
+// The package is not an API version package, but its root types are still checked for deprecation warnings.
+// +kubebuilder:object:root=true
+// +kubebuilder:deprecatedversion:warning=""
+type Deprecated struct { // want "Kind Deprecated is marked as a deprecated version with the marker \"kubebuilder:deprecatedversion\", but has no warning. Set the warning, e.g. kubebuilder:deprecatedversion:warning=\"...\", to tell users which version to use instead"
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:deprecatedversion:warning="a Deprecated is deprecated"
+type DeprecatedWithWarning struct {
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+type Stored struct {
+	Name string `json:"name"`
+}
+
+// The storage version must be served, even when the Kind has a single version.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:unservedversion
+type UnservedStored struct { // want "Kind UnservedStored is marked as the storage version with the marker \"kubebuilder:storageversion\", but is not served, as it is marked with the marker \"kubebuilder:unservedversion\". The storage version must be served"
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:unservedversion
+type Unserved struct {
+	Name string `json:"name"`
+}
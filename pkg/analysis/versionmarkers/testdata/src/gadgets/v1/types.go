@@ -0,0 +1,24 @@
+package v1
+
+// +kubebuilder:object:root=true
+type Widget struct {
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+type Gizmo struct { // want "Kind Gizmo is marked as the storage version in the versions v1beta1, v1. Exactly one version must be the storage version"
+	Name string `json:"name"`
+}
+
+// Doohickey is the newest version of the Kind, and so reports that no version is the storage version.
+// +kubebuilder:object:root=true
+type Doohickey struct { // want "Kind Doohickey has the versions v1alpha1, v1, but none of them is marked as the storage version with the marker \"kubebuilder:storageversion\". Exactly one version must be the storage version"
+	Name string `json:"name"`
+}
+
+// Sprocket has a single version, and so does not need a storage version marker.
+// +kubebuilder:object:root=true
+type Sprocket struct {
+	Name string `json:"name"`
+}
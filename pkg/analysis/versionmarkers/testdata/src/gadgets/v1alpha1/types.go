@@ -0,0 +1,34 @@
+package v1alpha1
+
+// Widget is stored in v1alpha1, although the stable v1 version exists, and v1alpha1 is not served.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:unservedversion
+// +kubebuilder:deprecatedversion
+type Widget struct { // want "Kind Widget is stored in the alpha version v1alpha1, but has the stable version v1. The storage version should be a stable version" "Kind Widget is marked as the storage version with the marker \"kubebuilder:storageversion\", but is not served, as it is marked with the marker \"kubebuilder:unservedversion\". The storage version must be served" "Kind Widget is marked as a deprecated version with the marker \"kubebuilder:deprecatedversion\", but has no warning. Set the warning, e.g. kubebuilder:deprecatedversion:warning=\"...\", to tell users which version to use instead"
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+type Doohickey struct {
+	Name string `json:"name"`
+}
+
+// Cog has no stable version, and so may be stored in its alpha version.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+type Cog struct {
+	Name string `json:"name"`
+}
+
+// CogList is not a root type, and so has no storage version.
+// +kubebuilder:object:root=true
+type CogList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+	Items    []Cog `json:"items"`
+}
+
+type TypeMeta struct{}
+
+type ListMeta struct{}
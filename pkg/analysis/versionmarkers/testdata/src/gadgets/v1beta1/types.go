@@ -0,0 +1,29 @@
+package v1beta1
+
+// +kubebuilder:object:root=true
+// +kubebuilder:deprecatedversion:warning="gadgets/v1beta1 Widget is deprecated, use gadgets/v1 Widget"
+type Widget struct {
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+type Gizmo struct { // want "Kind Gizmo is marked as the storage version in the versions v1beta1, v1. Exactly one version must be the storage version"
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+type Cog struct {
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+type CogList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+	Items    []Cog `json:"items"`
+}
+
+type TypeMeta struct{}
+
+type ListMeta struct{}
@@ -24,4 +24,11 @@ type GolangCIConfig struct {
 
 	// LintersConfig contains configuration for individual linters.
 	LintersConfig LintersConfig `mapstructure:"lintersConfig"`
+
+	// Diagnostics allows the user to configure how issues are reported.
+	Diagnostics Diagnostics `mapstructure:"diagnostics"`
+
+	// Preferences contains preferences that are shared by all linters,
+	// such as the preferred style of optional, required and default markers.
+	Preferences Preferences `mapstructure:"preferences"`
 }
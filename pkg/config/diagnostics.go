@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+// FieldPathStyle determines how fields are identified within diagnostic messages.
+type FieldPathStyle string
+
+const (
+	// FieldPathStyleGoName identifies fields by the Go struct name and the Go field name,
+	// e.g. `FooSpec.Bar`.
+	FieldPathStyleGoName FieldPathStyle = "GoName"
+
+	// FieldPathStyleJSONPath identifies fields by the serialized path at which they are
+	// reachable from a root object, prefixed by the Kind of the root object, e.g. `Deployment.spec.template.spec.containers[*].name`.
+	// Fields reachable at several paths are identified by each of their paths, separated by commas.
+	// Fields that are not reachable from a root object fall back to the GoName style.
+	FieldPathStyleJSONPath FieldPathStyle = "JSONPath"
+)
+
+// Diagnostics allows the user to configure how issues are reported by the linters.
+type Diagnostics struct {
+	// FieldPathStyle determines how fields are identified within diagnostic messages.
+	// Valid values are "GoName" and "JSONPath".
+	// When set to "GoName", fields are identified by the Go struct and field names, e.g. `FooSpec.Bar`.
+	// When set to "JSONPath", fields are identified by the serialized path from the root object,
+	// e.g. `spec.bar`.
+	// When otherwise not specified, the default value is "GoName".
+	FieldPathStyle FieldPathStyle `mapstructure:"fieldPathStyle"`
+
+	// Severity allows the default severity of checks to be overridden.
+	// Keys are either a check ID, e.g. `conditions/missing-listtype`, or a linter name, e.g. `conditions`.
+	// When a linter name is used, the severity applies to all checks within that linter.
+	// Where both a check ID and its linter name are present, the check ID takes precedence.
+	// Valid values are "Error", "Warning" and "Info".
+	Severity map[string]Severity `mapstructure:"severity"`
+
+	// Disable is a list of check IDs that should not be reported.
+	// This allows individual checks to be turned off without disabling the rest of the linter.
+	Disable []string `mapstructure:"disable"`
+}
+
+// Severity is the severity with which a diagnostic is reported.
+type Severity string
+
+const (
+	// SeverityError indicates that the diagnostic is likely to cause problems for API consumers
+	// and should be fixed.
+	SeverityError Severity = "Error"
+
+	// SeverityWarning indicates that the diagnostic does not follow the API conventions
+	// and should be fixed unless there is a good reason not to.
+	SeverityWarning Severity = "Warning"
+
+	// SeverityInfo indicates that the diagnostic is informational only.
+	SeverityInfo Severity = "Info"
+)
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+// MarkerStyle determines which family of markers is preferred when a marker
+// can be written in more than one way.
+type MarkerStyle string
+
+const (
+	// MarkerStyleStandard prefers the plain markers, e.g. `+optional`, `+required` and `+default`.
+	MarkerStyleStandard MarkerStyle = "Standard"
+
+	// MarkerStyleKubebuilder prefers the kubebuilder markers, e.g. `+kubebuilder:validation:Optional`,
+	// `+kubebuilder:validation:Required` and `+kubebuilder:default`.
+	MarkerStyleKubebuilder MarkerStyle = "Kubebuilder"
+
+	// MarkerStyleDeclarative prefers the declarative validation markers, e.g. `+k8s:optional`,
+	// `+k8s:required` and `+k8s:default`.
+	MarkerStyleDeclarative MarkerStyle = "Declarative"
+)
+
+// Preferences contains preferences that are shared by all linters.
+// Linters that have an equivalent setting inherit the preference when their own
+// setting is omitted, and must not contradict the preference when it is set.
+type Preferences struct {
+	// OptionalMarker is the preferred style of marker for optional fields.
+	// Valid values are "Standard", "Kubebuilder" and "Declarative".
+	// When omitted, each linter uses its own preferred optional marker.
+	OptionalMarker MarkerStyle `mapstructure:"optionalMarker"`
+
+	// RequiredMarker is the preferred style of marker for required fields.
+	// Valid values are "Standard", "Kubebuilder" and "Declarative".
+	// When omitted, each linter uses its own preferred required marker.
+	RequiredMarker MarkerStyle `mapstructure:"requiredMarker"`
+
+	// DefaultMarker is the preferred style of marker for default values.
+	// Valid values are "Standard", "Kubebuilder" and "Declarative".
+	// When omitted, each linter uses its own preferred default marker.
+	DefaultMarker MarkerStyle `mapstructure:"defaultMarker"`
+}
+
+// MarkerKind identifies the kind of marker that a preference applies to.
+type MarkerKind string
+
+const (
+	// MarkerKindOptional identifies markers for optional fields.
+	MarkerKindOptional MarkerKind = "Optional"
+
+	// MarkerKindRequired identifies markers for required fields.
+	MarkerKindRequired MarkerKind = "Required"
+
+	// MarkerKindDefault identifies markers for default values.
+	MarkerKindDefault MarkerKind = "Default"
+)
+
+// MarkerPreference links a setting within a linter configuration to
+// one of the shared marker preferences.
+type MarkerPreference struct {
+	// Kind is the kind of marker configured by the setting.
+	Kind MarkerKind
+
+	// Field is the serialized name of the setting within the linter configuration.
+	Field string
+
+	// Value points to the value of the setting, so that it may be inherited.
+	Value *string
+
+	// Styles are the marker styles supported by the setting.
+	// When empty, all marker styles are supported.
+	// Preferences for unsupported styles are not inherited by the setting.
+	Styles []MarkerStyle
+}
+
+// PreferencesInheritor is implemented by linter configurations that contain
+// settings equivalent to the shared Preferences.
+type PreferencesInheritor interface {
+	// MarkerPreferences returns the settings within the configuration that
+	// correspond to the shared marker preferences.
+	MarkerPreferences() []MarkerPreference
+}
@@ -27,6 +27,12 @@ const (
 
 	// DefaultMarker is the marker that specifies the default value of a field or type.
 	DefaultMarker = "default"
+
+	// UnionDiscriminatorMarker is the marker that indicates that a field is the discriminator of a union.
+	UnionDiscriminatorMarker = "unionDiscriminator"
+
+	// FeatureGateMarker is the marker that indicates that a field is gated by a feature gate.
+	FeatureGateMarker = "featureGate"
 )
 
 const (
@@ -36,6 +42,24 @@ const (
 	// KubebuilderStatusSubresourceMarker is the marker that indicates that the CRD generated for a struct should include the /status subresource.
 	KubebuilderStatusSubresourceMarker = "kubebuilder:subresource:status"
 
+	// KubebuilderScaleSubresourceMarker is the marker that indicates that the CRD generated for a struct should include the /scale subresource.
+	KubebuilderScaleSubresourceMarker = "kubebuilder:subresource:scale"
+
+	// KubebuilderResourceMarker is the marker that configures the resource of the CRD generated for a struct, such as its scope.
+	KubebuilderResourceMarker = "kubebuilder:resource"
+
+	// KubebuilderPrintColumnMarker is the marker that adds an additional printer column to the CRD generated for a struct.
+	KubebuilderPrintColumnMarker = "kubebuilder:printcolumn"
+
+	// KubebuilderStorageVersionMarker is the marker that indicates that the version of a struct is the storage version of its CRD.
+	KubebuilderStorageVersionMarker = "kubebuilder:storageversion"
+
+	// KubebuilderUnservedVersionMarker is the marker that indicates that the version of a struct is not served by the API server.
+	KubebuilderUnservedVersionMarker = "kubebuilder:unservedversion"
+
+	// KubebuilderDeprecatedVersionMarker is the marker that indicates that the version of a struct is deprecated, with an optional warning.
+	KubebuilderDeprecatedVersionMarker = "kubebuilder:deprecatedversion"
+
 	// KubebuilderAtLeastOneOfMarker is the marker that indicates that a type has a CEL validation in kubebuilder enforcing that at least one field is set.
 	KubebuilderAtLeastOneOfMarker = "kubebuilder:validation:AtLeastOneOf"
 
@@ -150,6 +174,9 @@ const (
 	// KubebuilderItemsUniqueItemsMarker is the marker used to specify that entries to a nested array type or field must contain unique items in kubebuilder.
 	KubebuilderItemsUniqueItemsMarker = "kubebuilder:validation:items:UniqueItems"
 
+	// KubebuilderXIntOrStringMarker is the marker used to specify that a type or field may be either an integer or a string in kubebuilder.
+	KubebuilderXIntOrStringMarker = "kubebuilder:validation:XIntOrString"
+
 	// KubebuilderXValidationMarker is the marker used to specify CEL validation rules for a type or field in kubebuilder.
 	KubebuilderXValidationMarker = "kubebuilder:validation:XValidation"
 
@@ -164,6 +191,12 @@ const (
 
 	// KubebuilderSchemaLessMarker is the marker that indicates that a struct is schemaless.
 	KubebuilderSchemaLessMarker = "kubebuilder:validation:Schemaless"
+
+	// KubebuilderPreserveUnknownFieldsMarker is the marker that indicates that unknown fields within a type or field should not be pruned.
+	KubebuilderPreserveUnknownFieldsMarker = "kubebuilder:pruning:PreserveUnknownFields"
+
+	// KubebuilderEmbeddedResourceMarker is the marker that indicates that a type or field contains an embedded Kubernetes resource, with apiVersion, kind and metadata.
+	KubebuilderEmbeddedResourceMarker = "kubebuilder:validation:EmbeddedResource"
 )
 
 const (
@@ -211,4 +244,34 @@ const (
 
 	// K8sDefaultMarker is the marker that indicates the default value for a field in k8s declarative validation.
 	K8sDefaultMarker = "k8s:default"
+
+	// K8sDeprecatedMarker is the marker that indicates that a field is deprecated in k8s declarative validation.
+	K8sDeprecatedMarker = "k8s:deprecated"
+
+	// K8sImmutableMarker is the marker that indicates that a field cannot be changed once set in k8s declarative validation.
+	K8sImmutableMarker = "k8s:immutable"
+
+	// K8sUpdateMarker is the marker that restricts how a field may change on update in k8s declarative validation.
+	K8sUpdateMarker = "k8s:update"
+
+	// K8sIfEnabledMarker is the marker that applies its payload only when a feature gate is enabled in k8s declarative validation.
+	K8sIfEnabledMarker = "k8s:ifEnabled"
+
+	// K8sIfDisabledMarker is the marker that applies its payload only when a feature gate is disabled in k8s declarative validation.
+	K8sIfDisabledMarker = "k8s:ifDisabled"
+
+	// K8sUnionDiscriminatorMarker is the marker that indicates that a field is the discriminator of a union in k8s declarative validation.
+	K8sUnionDiscriminatorMarker = "k8s:unionDiscriminator"
+
+	// K8sEachValMarker is the marker that applies its payload to each value of a list or map in k8s declarative validation.
+	K8sEachValMarker = "k8s:eachVal"
+
+	// K8sEachKeyMarker is the marker that applies its payload to each key of a map in k8s declarative validation.
+	K8sEachKeyMarker = "k8s:eachKey"
+
+	// K8sItemMarker is the marker that applies its payload to the item of a map-type list with the given key values in k8s declarative validation.
+	K8sItemMarker = "k8s:item"
+
+	// K8sSubfieldMarker is the marker that applies its payload to the named field of a struct in k8s declarative validation.
+	K8sSubfieldMarker = "k8s:subfield"
 )
@@ -21,6 +21,9 @@ import (
 	"github.com/golangci/plugin-module-register/register"
 	"golang.org/x/tools/go/analysis"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/driver"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/helpers/inspector"
 	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
 	"sigs.k8s.io/kube-api-linter/pkg/config"
 	"sigs.k8s.io/kube-api-linter/pkg/validation"
@@ -54,12 +57,32 @@ func (f *GolangCIPlugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
 		return nil, fmt.Errorf("error in KAL configuration: %w", err)
 	}
 
-	analyzers, err := registry.DefaultRegistry().InitializeLinters(f.config.Linters, f.config.LintersConfig)
+	if err := configureDiagnostics(f.config.Diagnostics); err != nil {
+		return nil, fmt.Errorf("error configuring diagnostics: %w", err)
+	}
+
+	analyzers, err := registry.DefaultRegistry().InitializeLinters(f.config.Linters, f.config.LintersConfig, f.config.Preferences)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing analyzers: %w", err)
 	}
 
-	return analyzers, nil
+	// Resolve the suggested fixes across all linters so that they can be applied together.
+	return driver.New(checks.Configure(analyzers, f.config.Diagnostics)), nil
+}
+
+// configureDiagnostics passes the diagnostics configuration through to the helper analyzers
+// that are responsible for how issues are reported.
+func configureDiagnostics(d config.Diagnostics) error {
+	fieldPathStyle := d.FieldPathStyle
+	if fieldPathStyle == "" {
+		fieldPathStyle = config.FieldPathStyleGoName
+	}
+
+	if err := inspector.Analyzer.Flags.Set(inspector.FieldPathStyleFlag, string(fieldPathStyle)); err != nil {
+		return fmt.Errorf("could not set field path style: %w", err)
+	}
+
+	return nil
 }
 
 // GetLoadMode implements the golangci-lint plugin interface.
@@ -25,14 +25,20 @@ package registration
 
 import (
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/arrayofstruct"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/collections"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/commentstart"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/conditions"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/conflictingmarkers"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/defaultorrequired"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/defaults"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/dependenttags"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/deprecatedfields"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/duplicatemarkers"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/dvmarkers"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/dvmigration"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/featuregates"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/forbiddenmarkers"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/immutability"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/integers"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/jsontags"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/maxlength"
@@ -41,18 +47,29 @@ import (
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nobools"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nodurations"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nofloats"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nointerfaces"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nomaps"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nonpointerstructs"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nonullable"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nophase"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/norecursivetypes"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/noreferences"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/nosecrets"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/notime"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/notimestamp"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/objectreferences"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/optionalfields"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/optionalorrequired"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/preferredmarkers"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/printcolumns"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/quantities"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/requiredfields"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/schemasize"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/ssatags"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/statusoptional"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/statussubresource"
 	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/uniquemarkers"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/unitsuffix"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/versionconsistency"
+	_ "sigs.k8s.io/kube-api-linter/pkg/analysis/versionmarkers"
 )
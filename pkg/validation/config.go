@@ -30,6 +30,8 @@ func ValidateGolangCIConfig(g config.GolangCIConfig, fldPath *field.Path) error
 	var fieldErrors field.ErrorList
 
 	fieldErrors = append(fieldErrors, ValidateLinters(g.Linters, fldPath.Child("linters"))...)
+	fieldErrors = append(fieldErrors, ValidateDiagnostics(g.Diagnostics, fldPath.Child("diagnostics"))...)
+	fieldErrors = append(fieldErrors, ValidatePreferences(g.Preferences, fldPath.Child("preferences"))...)
 
 	return fieldErrors.ToAggregate()
 }
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/checks"
+	"sigs.k8s.io/kube-api-linter/pkg/analysis/registry"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+// ValidateDiagnostics is used to validate the configuration in the config.Diagnostics struct.
+func ValidateDiagnostics(d config.Diagnostics, fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	switch d.FieldPathStyle {
+	case "", config.FieldPathStyleGoName, config.FieldPathStyleJSONPath:
+	default:
+		fieldErrors = append(fieldErrors, field.Invalid(fldPath.Child("fieldPathStyle"), d.FieldPathStyle, fmt.Sprintf("invalid value, must be one of %q, %q or omitted", config.FieldPathStyleGoName, config.FieldPathStyleJSONPath)))
+	}
+
+	fieldErrors = append(fieldErrors, validateSeverity(d.Severity, fldPath.Child("severity"))...)
+	fieldErrors = append(fieldErrors, validateDisabledChecks(d.Disable, fldPath.Child("disable"))...)
+
+	return fieldErrors
+}
+
+// validateSeverity validates that each key is a known check ID or linter name,
+// and that each value is a known severity.
+func validateSeverity(severity map[string]config.Severity, fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	// Iterate in a stable order so that errors are reported consistently.
+	keys := make([]string, 0, len(severity))
+	for key := range severity {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if !isKnownCheckOrLinter(key) {
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Key(key), key, "unknown check or linter"))
+		}
+
+		switch severity[key] {
+		case config.SeverityError, config.SeverityWarning, config.SeverityInfo:
+		default:
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Key(key), severity[key], fmt.Sprintf("invalid value, must be one of %q, %q or %q", config.SeverityError, config.SeverityWarning, config.SeverityInfo)))
+		}
+	}
+
+	return fieldErrors
+}
+
+// validateDisabledChecks validates that each disabled check is a known check ID.
+func validateDisabledChecks(disable []string, fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	for i, id := range disable {
+		if _, ok := checks.DefaultRegistry().Get(id); !ok {
+			fieldErrors = append(fieldErrors, field.Invalid(fldPath.Index(i), id, "unknown check"))
+		}
+	}
+
+	return fieldErrors
+}
+
+// isKnownCheckOrLinter returns whether the key is a registered check ID or linter name.
+func isKnownCheckOrLinter(key string) bool {
+	if _, ok := checks.DefaultRegistry().Get(key); ok {
+		return true
+	}
+
+	return registry.DefaultRegistry().AllLinters().Has(key)
+}
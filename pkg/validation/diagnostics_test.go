@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/validation"
+)
+
+var _ = Describe("Diagnostics", func() {
+	type validateDiagnosticsTableInput struct {
+		config      config.Diagnostics
+		expectedErr string
+	}
+
+	DescribeTable("Validate Diagnostics Configuration", func(in validateDiagnosticsTableInput) {
+		errs := validation.ValidateDiagnostics(in.config, field.NewPath("diagnostics"))
+		if len(in.expectedErr) > 0 {
+			Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+		} else {
+			Expect(errs).To(HaveLen(0), "No errors were expected")
+		}
+	},
+		Entry("Empty config", validateDiagnosticsTableInput{
+			config:      config.Diagnostics{},
+			expectedErr: "",
+		}),
+		Entry("With GoName field path style", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				FieldPathStyle: config.FieldPathStyleGoName,
+			},
+			expectedErr: "",
+		}),
+		Entry("With JSONPath field path style", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				FieldPathStyle: config.FieldPathStyleJSONPath,
+			},
+			expectedErr: "",
+		}),
+		Entry("With an invalid field path style", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				FieldPathStyle: "Invalid",
+			},
+			expectedErr: "diagnostics.fieldPathStyle: Invalid value: \"Invalid\": invalid value, must be one of \"GoName\", \"JSONPath\" or omitted",
+		}),
+		Entry("With severity overrides for a check and a linter", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				Severity: map[string]config.Severity{
+					"ssatags/missing-listtype": config.SeverityError,
+					"conditions":               config.SeverityInfo,
+				},
+			},
+			expectedErr: "",
+		}),
+		Entry("With a severity override for an unknown check", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				Severity: map[string]config.Severity{
+					"ssatags/unknown": config.SeverityError,
+				},
+			},
+			expectedErr: "diagnostics.severity[ssatags/unknown]: Invalid value: \"ssatags/unknown\": unknown check or linter",
+		}),
+		Entry("With an invalid severity", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				Severity: map[string]config.Severity{
+					"ssatags": "Critical",
+				},
+			},
+			expectedErr: "diagnostics.severity[ssatags]: Invalid value: \"Critical\": invalid value, must be one of \"Error\", \"Warning\" or \"Info\"",
+		}),
+		Entry("With disabled checks", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				Disable: []string{"ssatags/listtype-set", "optionalfields/add-omitzero"},
+			},
+			expectedErr: "",
+		}),
+		Entry("With an unknown disabled check", validateDiagnosticsTableInput{
+			config: config.Diagnostics{
+				Disable: []string{"ssatags"},
+			},
+			expectedErr: "diagnostics.disable[0]: Invalid value: \"ssatags\": unknown check",
+		}),
+	)
+})
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+)
+
+// ValidatePreferences is used to validate the configuration in the config.Preferences struct.
+func ValidatePreferences(p config.Preferences, fldPath *field.Path) field.ErrorList {
+	fieldErrors := field.ErrorList{}
+
+	fieldErrors = append(fieldErrors, validateMarkerStyle(p.OptionalMarker, fldPath.Child("optionalMarker"))...)
+	fieldErrors = append(fieldErrors, validateMarkerStyle(p.RequiredMarker, fldPath.Child("requiredMarker"))...)
+	fieldErrors = append(fieldErrors, validateMarkerStyle(p.DefaultMarker, fldPath.Child("defaultMarker"))...)
+
+	return fieldErrors
+}
+
+// validateMarkerStyle validates that the marker style is a known marker style.
+func validateMarkerStyle(style config.MarkerStyle, fldPath *field.Path) field.ErrorList {
+	switch style {
+	case "", config.MarkerStyleStandard, config.MarkerStyleKubebuilder, config.MarkerStyleDeclarative:
+		return nil
+	default:
+		return field.ErrorList{field.Invalid(fldPath, style, fmt.Sprintf("invalid value, must be one of %q, %q, %q or omitted", config.MarkerStyleStandard, config.MarkerStyleKubebuilder, config.MarkerStyleDeclarative))}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/kube-api-linter/pkg/config"
+	"sigs.k8s.io/kube-api-linter/pkg/validation"
+)
+
+var _ = Describe("Preferences", func() {
+	type validatePreferencesTableInput struct {
+		config      config.Preferences
+		expectedErr string
+	}
+
+	DescribeTable("Validate Preferences Configuration", func(in validatePreferencesTableInput) {
+		errs := validation.ValidatePreferences(in.config, field.NewPath("preferences"))
+		if len(in.expectedErr) > 0 {
+			Expect(errs.ToAggregate()).To(MatchError(in.expectedErr))
+		} else {
+			Expect(errs).To(HaveLen(0), "No errors were expected")
+		}
+	},
+		Entry("Empty config", validatePreferencesTableInput{
+			config:      config.Preferences{},
+			expectedErr: "",
+		}),
+		Entry("With Standard marker styles", validatePreferencesTableInput{
+			config: config.Preferences{
+				OptionalMarker: config.MarkerStyleStandard,
+				RequiredMarker: config.MarkerStyleStandard,
+				DefaultMarker:  config.MarkerStyleStandard,
+			},
+			expectedErr: "",
+		}),
+		Entry("With Kubebuilder marker styles", validatePreferencesTableInput{
+			config: config.Preferences{
+				OptionalMarker: config.MarkerStyleKubebuilder,
+				RequiredMarker: config.MarkerStyleKubebuilder,
+				DefaultMarker:  config.MarkerStyleKubebuilder,
+			},
+			expectedErr: "",
+		}),
+		Entry("With Declarative marker styles", validatePreferencesTableInput{
+			config: config.Preferences{
+				OptionalMarker: config.MarkerStyleDeclarative,
+				RequiredMarker: config.MarkerStyleDeclarative,
+			},
+			expectedErr: "",
+		}),
+		Entry("With an invalid optional marker style", validatePreferencesTableInput{
+			config: config.Preferences{
+				OptionalMarker: "Invalid",
+			},
+			expectedErr: "preferences.optionalMarker: Invalid value: \"Invalid\": invalid value, must be one of \"Standard\", \"Kubebuilder\", \"Declarative\" or omitted",
+		}),
+		Entry("With an invalid required marker style", validatePreferencesTableInput{
+			config: config.Preferences{
+				RequiredMarker: "Invalid",
+			},
+			expectedErr: "preferences.requiredMarker: Invalid value: \"Invalid\": invalid value, must be one of \"Standard\", \"Kubebuilder\", \"Declarative\" or omitted",
+		}),
+		Entry("With an invalid default marker style", validatePreferencesTableInput{
+			config: config.Preferences{
+				DefaultMarker: "Invalid",
+			},
+			expectedErr: "preferences.defaultMarker: Invalid value: \"Invalid\": invalid value, must be one of \"Standard\", \"Kubebuilder\", \"Declarative\" or omitted",
+		}),
+	)
+})